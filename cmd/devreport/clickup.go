@@ -0,0 +1,126 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/Afrawles/devreport/internal/clickup"
+	"github.com/spf13/cobra"
+)
+
+var (
+	clickupCmd = &cobra.Command{
+		Use:   "clickup",
+		Short: "ClickUp helpers",
+	}
+
+	discoverCmd = &cobra.Command{
+		Use:   "discover",
+		Short: "List ClickUp workspaces, spaces, folders, lists and members with their IDs",
+		Long: `Walks every workspace the token can see and prints its spaces, folders,
+lists and members with their IDs, as a tree or as JSON.`,
+		Run: discoverClickUp,
+	}
+
+	discoverJSON bool
+)
+
+func init() {
+	rootCmd.AddCommand(clickupCmd)
+	clickupCmd.AddCommand(discoverCmd)
+
+	discoverCmd.Flags().StringVar(&clickUpToken, "clickup-token", "", "ClickUp API token (defaults to CLICKUP_API_KEY)")
+	discoverCmd.Flags().StringVar(&clickupTeamID, "clickup-team-id", "", "Only walk this workspace (defaults to CLICKUP_TEAM_ID)")
+	discoverCmd.Flags().BoolVar(&discoverJSON, "json", false, "Print JSON instead of a tree")
+}
+
+func discoverClickUp(cmd *cobra.Command, args []string) {
+	token := clickUpToken
+	if token == "" {
+		token = os.Getenv("CLICKUP_API_KEY")
+	}
+	if token == "" {
+		fmt.Println("Error: --clickup-token or CLICKUP_API_KEY is required")
+		return
+	}
+
+	workspaces, err := clickup.NewClient(token, nil, nil).Discover(clickupTeam())
+	if err != nil {
+		fmt.Printf("Error discovering ClickUp workspaces: %v\n", err)
+		return
+	}
+
+	if discoverJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(workspaces); err != nil {
+			fmt.Printf("Error encoding JSON: %v\n", err)
+		}
+		return
+	}
+
+	for _, ws := range workspaces {
+		fmt.Printf("Workspace %s (%s)\n", ws.Name, ws.ID)
+		fmt.Println("  Members")
+		for _, m := range ws.Members {
+			fmt.Printf("    %s <%s> (%d)\n", m.Username, m.Email, m.ID)
+		}
+		for _, space := range ws.Spaces {
+			fmt.Printf("  Space %s (%s)\n", space.Name, space.ID)
+			for _, folder := range space.Folders {
+				fmt.Printf("    Folder %s (%s)\n", folder.Name, folder.ID)
+				for _, l := range folder.Lists {
+					fmt.Printf("      List %s (%s)\n", l.Name, l.ID)
+				}
+			}
+			for _, l := range space.Lists {
+				fmt.Printf("    List %s (%s)\n", l.Name, l.ID)
+			}
+		}
+		fmt.Println()
+	}
+}
+
+// clickupRefs holds the lists, folder and assignees given on the command line,
+// each either an ID or a name (email for assignees).
+type clickupRefs struct {
+	lists     []string
+	folder    string
+	assignees []string
+	// listNames maps list IDs to names when the workspace hierarchy was fetched.
+	listNames map[string]string
+}
+
+// resolve replaces names with IDs. The workspace hierarchy is only fetched
+// when a name was given.
+func (r *clickupRefs) resolve(token string) error {
+	named := r.folder != "" && !clickup.IsID(r.folder)
+	for _, ref := range append(append([]string{}, r.lists...), r.assignees...) {
+		if !clickup.IsID(ref) {
+			named = true
+		}
+	}
+	if !named {
+		return nil
+	}
+
+	workspaces, err := clickup.NewClient(token, nil, nil).Discover(clickupTeam())
+	if err != nil {
+		return fmt.Errorf("failed to look up ClickUp names: %w", err)
+	}
+
+	if r.lists, err = workspaces.ListIDs(r.lists); err != nil {
+		return err
+	}
+	r.listNames = workspaces.ListNames()
+	if r.folder != "" {
+		if r.folder, err = workspaces.FolderID(r.folder); err != nil {
+			return err
+		}
+	}
+	if r.assignees, err = workspaces.MemberIDs(r.assignees); err != nil {
+		return err
+	}
+	return nil
+}
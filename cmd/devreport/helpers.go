@@ -3,32 +3,89 @@ package main
 import (
 	"fmt"
 	"strings"
+
+	"github.com/Afrawles/devreport/internal/report"
 )
 
-// parseCommaList splits a comma-separated string and trims whitespace
-func parseCommaList(input string) []string {
-	if input == "" {
-		return []string{}
+// splitList splits a comma-separated string, trimming whitespace and dropping empty entries.
+// Entries are kept verbatim, so it suits IDs, names and paths.
+func splitList(input string) []string {
+	var result []string
+	for _, part := range strings.Split(input, ",") {
+		if trimmed := strings.TrimSpace(part); trimmed != "" {
+			result = append(result, trimmed)
+		}
 	}
-	
-	parts := strings.Split(input, ",")
-	result := make([]string, len(parts))
-	for i, part := range parts {
-		trimmed := strings.TrimSpace(part)
-		if strings.Contains(trimmed, "|") {
-			bullets := strings.Split(trimmed, "|")
-			var bulletList []string
-			for _, bullet := range bullets {
-				trimmedBullet := strings.TrimSpace(bullet)
-				if trimmedBullet != "" {
-					bulletList = append(bulletList, fmt.Sprintf("• %s", trimmedBullet))
-				}
+	return result
+}
+
+// parseCommaList splits a comma-separated string of free text like splitList,
+// turning pipe-separated sentences within an entry into bullet points.
+func parseCommaList(input string) []string {
+	result := splitList(input)
+	for i, entry := range result {
+		if !strings.Contains(entry, "|") {
+			continue
+		}
+		var bulletList []string
+		for _, bullet := range strings.Split(entry, "|") {
+			if trimmedBullet := strings.TrimSpace(bullet); trimmedBullet != "" {
+				bulletList = append(bulletList, fmt.Sprintf("• %s", trimmedBullet))
 			}
-			result[i] = strings.Join(bulletList, "\n")
-		} else {
-			result[i] = trimmed
 		}
+		result[i] = strings.Join(bulletList, "\n")
 	}
 	return result
 }
 
+// parseTaskNotes parses comma-separated "<task ID or URL>=<text>" entries into
+// text by task. Pipes within an entry separate bullet points, as in parseCommaList.
+func parseTaskNotes(flag, input string) (map[string]string, error) {
+	notes := make(map[string]string)
+	for _, entry := range strings.Split(input, ",") {
+		if strings.TrimSpace(entry) == "" {
+			continue
+		}
+		key, text, ok := strings.Cut(entry, "=")
+		key = strings.TrimSpace(key)
+		if !ok || key == "" {
+			return nil, fmt.Errorf("--%s entry %q must be <task ID or URL>=<text>", flag, strings.TrimSpace(entry))
+		}
+		if texts := parseCommaList(text); len(texts) > 0 {
+			notes[key] = texts[0]
+		}
+	}
+	return notes, nil
+}
+
+// applyTaskNotes fills field on the tasks and subtasks named in notes, leaving
+// fields a source already set. It returns the keys that matched no task.
+func applyTaskNotes(tasks []report.Task, notes map[string]string, field func(*report.Task) *string) []string {
+	used := make(map[string]bool)
+	var apply func(tasks []report.Task)
+	apply = func(tasks []report.Task) {
+		for i := range tasks {
+			task := &tasks[i]
+			for _, key := range []string{task.ID, task.URL} {
+				text, ok := notes[key]
+				if !ok || key == "" {
+					continue
+				}
+				used[key] = true
+				if value := field(task); *value == "" {
+					*value = text
+				}
+			}
+			apply(task.Children)
+		}
+	}
+	apply(tasks)
+
+	var unmatched []string
+	for key := range notes {
+		if !used[key] {
+			unmatched = append(unmatched, key)
+		}
+	}
+	return unmatched
+}
@@ -4,11 +4,17 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/Afrawles/devreport/internal/clickup"
 	"github.com/Afrawles/devreport/internal/github"
+	"github.com/Afrawles/devreport/internal/gitlab"
+	"github.com/Afrawles/devreport/internal/jira"
+	"github.com/Afrawles/devreport/internal/linear"
+	"github.com/Afrawles/devreport/internal/llm"
+	"github.com/Afrawles/devreport/internal/localgit"
 	"github.com/Afrawles/devreport/internal/report"
 	"github.com/spf13/cobra"
 
@@ -24,6 +30,10 @@ var (
 	clickUpAssignees            string
 	clickupListIDs              string
 	clickupFolderID             string
+	clickupTimeTracking         bool
+	clickupTeamID               string
+	clickupFieldMap             string
+	clickupComments             bool
 	author                      string
 	category                    string
 	challenges                  string
@@ -31,6 +41,11 @@ var (
 	supportFrom                 string
 	followUp                    string
 	period                      string
+	dateFieldFlag               string
+	statusMapFile               string
+	llmProvider                 string
+	llmURL                      string
+	llmModel                    string
 	year                        int
 	csvOutput                   string
 	githubToken                 string
@@ -38,8 +53,42 @@ var (
 	githubUsername              string
 	githubIncludeReviewedPRs    bool
 	githubIncludeAssignedIssues bool
-
-	githubRepos string
+	githubIncludeCommits        bool
+	githubIncludeComments       bool
+	githubIncludeReleases       bool
+	githubIncludeTags           bool
+
+	githubRepos    string
+	githubBranches string
+	githubAPI      string
+
+	githubConcurrency int
+	githubURL         string
+
+	githubAppID             int64
+	githubAppInstallationID int64
+	githubAppPrivateKey     string
+
+	gitlabToken    string
+	gitlabURL      string
+	gitlabGroups   string
+	gitlabProjects string
+	gitlabUsername string
+
+	jiraURL      string
+	jiraToken    string
+	jiraEmail    string
+	jiraAuth     string
+	jiraProjects string
+	jiraAssignee string
+
+	linearToken string
+	linearUser  string
+	linearTeams string
+
+	localGitRepos    string
+	localGitAuthors  string
+	localGitBranches string
 )
 
 var rootCmd = &cobra.Command{
@@ -78,20 +127,29 @@ func init() {
 
 	// clickup
 	rootCmd.Flags().StringVar(&clickUpToken, "clickup-token", "", "ClickUp API token")
-	rootCmd.Flags().StringVar(&clickUpAssignees, "clickup-assignees", "", "Comma-separated ClickUp assignee IDs")
-	rootCmd.Flags().StringVar(&clickupListIDs, "clickup-listid", "", "CLickup List IDs")
-	rootCmd.Flags().StringVar(&clickupFolderID, "clickup-folderid", "", "ClickUp Folder ID (alternative to list IDs)")
+	rootCmd.Flags().StringVar(&clickUpAssignees, "clickup-assignees", "", "Comma-separated ClickUp assignee IDs, usernames or emails")
+	rootCmd.Flags().StringVar(&clickupListIDs, "clickup-listid", "", "Comma-separated ClickUp list IDs or names")
+	rootCmd.Flags().StringVar(&clickupFolderID, "clickup-folderid", "", "ClickUp folder ID or name (alternative to list IDs)")
+	rootCmd.Flags().StringVar(&clickupFieldMap, "clickup-field-map", "", "JSON file mapping ClickUp custom field names to report fields (defaults to CLICKUP_FIELD_MAP)")
+	rootCmd.Flags().BoolVar(&clickupComments, "clickup-comments", false, "Also read #challenge, #support, #blocked-by and #followup entries from task comments, one request per task (checklists are always read)")
+	rootCmd.Flags().BoolVar(&clickupTimeTracking, "clickup-time-tracking", false, "Attach time tracked in ClickUp to tasks")
+	rootCmd.Flags().StringVar(&clickupTeamID, "clickup-team-id", "", "ClickUp workspace (team) ID for time entries (defaults to CLICKUP_TEAM_ID, then the token's only workspace)")
 
 	rootCmd.Flags().StringVar(&category, "category", "Improvements/Issues/New Development/Urgent Support/Fixes", "Category suffix for list names")
 
 	rootCmd.Flags().StringVar(&author, "author", "", "report author")
 
-	rootCmd.Flags().StringVar(&challenges, "challenges", "", "Comma-separated challenges encountered (one per list)")
-	rootCmd.Flags().StringVar(&supportRequired, "support-required", "", "Comma-separated support required (one per list)")
-	rootCmd.Flags().StringVar(&supportFrom, "support-from", "", "Comma-separated support from (one per list)")
-	rootCmd.Flags().StringVar(&followUp, "follow-up", "", "Comma-separated follow up activities (one per list)")
+	rootCmd.Flags().StringVar(&challenges, "challenges", "", "Comma-separated <task ID or URL>=<text> challenges, | separating bullets (only fills tasks without #challenge entries)")
+	rootCmd.Flags().StringVar(&supportRequired, "support-required", "", "Comma-separated <task ID or URL>=<text> support required, | separating bullets (only fills tasks without #support entries)")
+	rootCmd.Flags().StringVar(&supportFrom, "support-from", "", "Comma-separated <task ID or URL>=<text> support from, | separating bullets (only fills tasks without #blocked-by entries)")
+	rootCmd.Flags().StringVar(&followUp, "follow-up", "", "Comma-separated <task ID or URL>=<text> follow up activities, | separating bullets (only fills tasks without #followup entries)")
 	rootCmd.Flags().StringVar(&period, "period", "Q2", "Reporting period (e.g., Q1, Q2, January, etc.)")
 	rootCmd.Flags().IntVar(&year, "year", time.Now().Year(), "Report year")
+	rootCmd.Flags().StringVar(&llmProvider, "llm-provider", "", "LLM used to rephrase activities: ollama, openai (any OpenAI-compatible server) or none (defaults to LLM_PROVIDER, then ollama)")
+	rootCmd.Flags().StringVar(&llmURL, "llm-url", "", "LLM server URL (defaults to LLM_URL, then http://localhost:11434 for ollama or http://localhost:8080/v1 for openai)")
+	rootCmd.Flags().StringVar(&llmModel, "llm-model", "", "LLM model name for every source (defaults to LLM_MODEL, then gemma4:e4b for ollama)")
+	rootCmd.Flags().StringVar(&statusMapFile, "status-map", "", "JSON file mapping status names to categories: todo, in progress, review, blocked, done, cancelled (defaults to STATUS_MAP)")
+	rootCmd.Flags().StringVar(&dateFieldFlag, "date-field", "created", "Date that places ClickUp tasks and GitHub PRs/issues in the period: created, updated, closed or any")
 
 	rootCmd.Flags().StringVar(&csvOutput, "csv", "", "Generate CSV summary reports (directory or filename prefix)")
 
@@ -99,17 +157,62 @@ func init() {
 	rootCmd.Flags().StringVar(&githubToken, "github-token", "", "GitHub personal access token")
 	rootCmd.Flags().StringVar(&githubOrgs, "github-orgs", "", "Comma-separated GitHub organization names")
 	rootCmd.Flags().StringVar(&githubUsername, "github-username", "", "GitHub username/login (defaults to GITHUB_USERNAME, then --user)")
-	rootCmd.Flags().BoolVar(&githubIncludeReviewedPRs, "github-include-reviewed-prs", false, "Include PRs reviewed by the user")
+	rootCmd.Flags().BoolVar(&githubIncludeReviewedPRs, "github-include-reviewed-prs", false, "Include PRs reviewed by the user as review activities")
 	rootCmd.Flags().BoolVar(&githubIncludeAssignedIssues, "github-include-assigned-issues", false, "Include issues assigned to the user")
+	rootCmd.Flags().BoolVar(&githubIncludeComments, "github-include-comments", false, "Include issue and PR comments by the user as discussion activities")
+	rootCmd.Flags().BoolVar(&githubIncludeReleases, "github-include-releases", false, "Include releases published by the user (from the last 90 days of GitHub events unless --github-repos is set)")
+	rootCmd.Flags().BoolVar(&githubIncludeTags, "github-include-tags", false, "Include tags created by the user (from the last 90 days of GitHub events)")
+	rootCmd.Flags().BoolVar(&githubIncludeCommits, "github-include-commits", false, "Include commits pushed directly to default branches (or --github-branches, within --github-repos) outside of PRs")
 
 	summaryCmd.Flags().StringVar(&periodFlag, "period", "this-week", "Period: today, yesterday, this-week, last-week, this-month, last-month, all-time")
 	summaryCmd.Flags().StringVar(&clickUpToken, "clickup-token", "", "ClickUp API token")
-	summaryCmd.Flags().StringVar(&clickupListIDs, "clickup-listid", "", "ClickUp List IDs (comma-separated)")
-	summaryCmd.Flags().StringVar(&clickupFolderID, "clickup-folderid", "", "ClickUp Folder ID (fetches all lists in folder)")
-	summaryCmd.Flags().StringVar(&clickUpAssignees, "clickup-assignees", "", "Filter by assignee IDs (optional)")
+	summaryCmd.Flags().StringVar(&clickupListIDs, "clickup-listid", "", "ClickUp list IDs or names (comma-separated)")
+	summaryCmd.Flags().StringVar(&clickupFolderID, "clickup-folderid", "", "ClickUp folder ID or name (fetches all lists in folder)")
+	summaryCmd.Flags().StringVar(&clickUpAssignees, "clickup-assignees", "", "Filter by assignee IDs, usernames or emails (optional)")
+	summaryCmd.Flags().StringVar(&clickupFieldMap, "clickup-field-map", "", "JSON file mapping ClickUp custom field names to report fields (defaults to CLICKUP_FIELD_MAP)")
+	summaryCmd.Flags().BoolVar(&clickupComments, "clickup-comments", false, "Also read #challenge, #support, #blocked-by and #followup entries from task comments, one request per task (checklists are always read)")
+	summaryCmd.Flags().BoolVar(&clickupTimeTracking, "clickup-time-tracking", false, "Attach time tracked in ClickUp to tasks")
+	summaryCmd.Flags().StringVar(&clickupTeamID, "clickup-team-id", "", "ClickUp workspace (team) ID for time entries (defaults to CLICKUP_TEAM_ID, then the token's only workspace)")
 	summaryCmd.Flags().StringVar(&csvOutput, "csv", "reports", "Output directory for CSV reports")
+	summaryCmd.Flags().StringVar(&llmProvider, "llm-provider", "", "LLM used to rephrase activities: ollama, openai (any OpenAI-compatible server) or none (defaults to LLM_PROVIDER, then ollama)")
+	summaryCmd.Flags().StringVar(&llmURL, "llm-url", "", "LLM server URL (defaults to LLM_URL, then http://localhost:11434 for ollama or http://localhost:8080/v1 for openai)")
+	summaryCmd.Flags().StringVar(&llmModel, "llm-model", "", "LLM model name for every source (defaults to LLM_MODEL, then gemma4:e4b for ollama)")
+	summaryCmd.Flags().StringVar(&statusMapFile, "status-map", "", "JSON file mapping status names to categories: todo, in progress, review, blocked, done, cancelled (defaults to STATUS_MAP)")
+	summaryCmd.Flags().StringVar(&dateFieldFlag, "date-field", "created", "Date that places tasks in the period: created, updated, closed or any")
 
 	rootCmd.Flags().StringVar(&githubRepos, "github-repos", "", "Comma-separated GitHub repository names to filter (optional, defaults to all org repos)")
+	rootCmd.Flags().StringVar(&githubAPI, "github-api", github.APIREST, "GitHub API backend: rest or graphql (graphql needs far fewer requests)")
+	rootCmd.Flags().Int64Var(&githubAppID, "github-app-id", 0, "GitHub App ID (authenticate as an app installation instead of a token)")
+	rootCmd.Flags().Int64Var(&githubAppInstallationID, "github-app-installation-id", 0, "GitHub App installation ID")
+	rootCmd.Flags().StringVar(&githubAppPrivateKey, "github-app-private-key", "", "Path to the GitHub App private key (PEM)")
+	rootCmd.Flags().StringVar(&githubURL, "github-url", "", "GitHub Enterprise Server URL (defaults to GITHUB_API_URL, then api.github.com)")
+	rootCmd.Flags().IntVar(&githubConcurrency, "github-concurrency", 4, "Number of GitHub repositories/PRs fetched in parallel")
+	rootCmd.Flags().StringVar(&githubBranches, "github-branches", "", "Comma-separated base branches or glob patterns to restrict PRs and reviewed PRs to, e.g. main,release/*; PR commits count once the PR's merge commit is on one (optional)")
+
+	// gitlab
+	rootCmd.Flags().StringVar(&gitlabToken, "gitlab-token", "", "GitLab personal access token (read_api scope)")
+	rootCmd.Flags().StringVar(&gitlabURL, "gitlab-url", "", "GitLab base URL for self-hosted instances (defaults to GITLAB_URL, then https://gitlab.com)")
+	rootCmd.Flags().StringVar(&gitlabGroups, "gitlab-groups", "", "Comma-separated GitLab group paths or IDs")
+	rootCmd.Flags().StringVar(&gitlabProjects, "gitlab-projects", "", "Comma-separated GitLab project paths or IDs (optional)")
+	rootCmd.Flags().StringVar(&gitlabUsername, "gitlab-username", "", "GitLab username (defaults to GITLAB_USERNAME, then --user)")
+
+	// jira
+	rootCmd.Flags().StringVar(&jiraURL, "jira-url", "", "Jira base URL (e.g. https://company.atlassian.net)")
+	rootCmd.Flags().StringVar(&jiraToken, "jira-token", "", "Jira API token (Cloud) or personal access token (Server/Data Center)")
+	rootCmd.Flags().StringVar(&jiraEmail, "jira-email", "", "Jira account email, used with --jira-token for basic auth")
+	rootCmd.Flags().StringVar(&jiraAuth, "jira-auth", "", "Jira auth mode: basic or bearer (defaults to basic when --jira-email is set)")
+	rootCmd.Flags().StringVar(&jiraProjects, "jira-projects", "", "Comma-separated Jira project keys (optional)")
+	rootCmd.Flags().StringVar(&jiraAssignee, "jira-assignee", "", "Jira assignee (account ID, username or email; defaults to the token owner)")
+
+	// linear
+	rootCmd.Flags().StringVar(&linearToken, "linear-token", "", "Linear personal API key")
+	rootCmd.Flags().StringVar(&linearUser, "linear-user", "", "Linear user email (defaults to LINEAR_USER, then the API key owner)")
+	rootCmd.Flags().StringVar(&linearTeams, "linear-teams", "", "Comma-separated Linear team keys (optional)")
+
+	// local git
+	rootCmd.Flags().StringVar(&localGitRepos, "local-git-repos", "", "Comma-separated local repository paths (or directories containing repositories)")
+	rootCmd.Flags().StringVar(&localGitAuthors, "local-git-authors", "", "Comma-separated commit author emails or names (defaults to each repo's user.email)")
+	rootCmd.Flags().StringVar(&localGitBranches, "local-git-branches", "", "Comma-separated branches or glob patterns, e.g. main,release/* (defaults to all branches)")
 }
 
 func generateReport(cmd *cobra.Command, args []string) {
@@ -142,6 +245,18 @@ func generateReport(cmd *cobra.Command, args []string) {
 		return
 	}
 
+	dateField, err := report.ParseDateField(dateFieldFlag)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	rephraser, err := llmRephraser()
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
 	fmt.Printf("Generating report for %s (%s to %s)\n",
 		username, start.Format("2006-01-02"), end.Format("2006-01-02"))
 
@@ -169,34 +284,48 @@ func generateReport(cmd *cobra.Command, args []string) {
 	}
 
 	if token != "" && assigneesStr != "" {
-		assigneeIDs := strings.Split(assigneesStr, ",")
-		for i := range assigneeIDs {
-			assigneeIDs[i] = strings.TrimSpace(assigneeIDs[i])
+		refs := clickupRefs{
+			lists:     splitList(listIDstr),
+			folder:    strings.TrimSpace(folderID),
+			assignees: splitList(assigneesStr),
 		}
+		if err := refs.resolve(token); err != nil {
+			fmt.Printf("Error: %v\n", err)
+			return
+		}
+		assigneeIDs := refs.assignees
 
 		var listIDs []string
 
-		if folderID != "" {
+		if refs.folder != "" {
 			bar := newSpinner("Fetching lists from folder")
 			defer finishBar(bar)
 
 			client := clickup.NewClient(token, nil, assigneeIDs)
-			listIDs, err = client.GetListIDsFromFolder(folderID)
+			listIDs, err = client.GetListIDsFromFolder(refs.folder)
 
 			if err != nil {
 				fmt.Printf("\nError fetching lists from folder: %v\n", err)
 				return
 			}
 			fmt.Printf("Found %d lists in folder\n\n", len(listIDs))
-		} else if listIDstr != "" {
-			listIDs = strings.Split(listIDstr, ",")
-			for i := range listIDs {
-				listIDs[i] = strings.TrimSpace(listIDs[i])
-			}
+		} else {
+			listIDs = refs.lists
 		}
 
 		if len(listIDs) > 0 {
-			sources = append(sources, clickup.NewClickUpSource(token, listIDs, assigneeIDs, category))
+			cuSource := clickup.NewClickUpSource(token, listIDs, assigneeIDs, category)
+			cuSource.TrackTime = clickupTimeTracking
+			cuSource.TeamID = clickupTeam()
+			cuSource.ReadComments = clickupComments
+			cuSource.DateField = dateField
+			cuSource.Rephraser = rephraser
+			cuSource.Fields, err = clickupFields()
+			if err != nil {
+				fmt.Printf("Error loading ClickUp field map: %v\n", err)
+				return
+			}
+			sources = append(sources, cuSource)
 		} else {
 			fmt.Println("No list IDs found. Provide --clickup-listid or --clickup-folderid")
 			return
@@ -224,7 +353,13 @@ func generateReport(cmd *cobra.Command, args []string) {
 		ghUsername = username
 	}
 
-	if ghToken != "" && orgStr != "" {
+	ghApp, err := githubAppAuth()
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	if (ghToken != "" || ghApp != nil) && orgStr != "" {
 		if strings.ContainsAny(ghUsername, " \t\n") {
 			fmt.Printf("GitHub username %q looks like a display name. Use --github-username with your GitHub login.\n", ghUsername)
 			return
@@ -243,23 +378,216 @@ func generateReport(cmd *cobra.Command, args []string) {
 			}
 		}
 
+		if githubAPI != github.APIREST && githubAPI != github.APIGraphQL {
+			fmt.Printf("Invalid --github-api %q. Use rest or graphql.\n", githubAPI)
+			return
+		}
+
+		branchStr := githubBranches
+		if branchStr == "" {
+			branchStr = os.Getenv("GITHUB_BRANCHES")
+		}
+
+		ghURL := githubURL
+		if ghURL == "" {
+			ghURL = os.Getenv("GITHUB_API_URL")
+		}
+
 		fmt.Printf("Using GitHub username: %s\n", ghUsername)
-		sources = append(sources, github.NewGitHubSource(ghToken, orgs, ghUsername, repos, githubIncludeReviewedPRs, githubIncludeAssignedIssues))
-	} else if ghToken != "" {
-		fmt.Println("GitHub token provided but orgs missing")
+		ghSource, err := github.NewGitHubSource(github.Options{
+			Token:                 ghToken,
+			App:                   ghApp,
+			Orgs:                  orgs,
+			Repos:                 repos,
+			Username:              ghUsername,
+			IncludeReviewedPRs:    githubIncludeReviewedPRs,
+			IncludeAssignedIssues: githubIncludeAssignedIssues,
+			IncludeCommits:        githubIncludeCommits,
+			IncludeComments:       githubIncludeComments,
+			IncludeReleases:       githubIncludeReleases,
+			IncludeTags:           githubIncludeTags,
+			Branches:              splitList(branchStr),
+			API:                   githubAPI,
+			Concurrency:           githubConcurrency,
+			BaseURL:               ghURL,
+			DateField:             dateField,
+		})
+		if err != nil {
+			fmt.Printf("Error configuring GitHub: %v\n", err)
+			return
+		}
+		ghSource.Rephraser = rephraser
+		sources = append(sources, ghSource)
+	} else if ghToken != "" || ghApp != nil {
+		fmt.Println("GitHub credentials provided but orgs missing")
+	}
+
+	// gitlab
+	glToken := gitlabToken
+	if glToken == "" {
+		glToken = os.Getenv("GITLAB_TOKEN")
+	}
+
+	if glToken != "" {
+		glURL := gitlabURL
+		if glURL == "" {
+			glURL = os.Getenv("GITLAB_URL")
+		}
+
+		groupStr := gitlabGroups
+		if groupStr == "" {
+			groupStr = os.Getenv("GITLAB_GROUPS")
+		}
+
+		projectStr := gitlabProjects
+		if projectStr == "" {
+			projectStr = os.Getenv("GITLAB_PROJECTS")
+		}
+
+		glUsername := gitlabUsername
+		if glUsername == "" {
+			glUsername = os.Getenv("GITLAB_USERNAME")
+		}
+		if glUsername == "" {
+			glUsername = username
+		}
+
+		fmt.Printf("Using GitLab username: %s\n", glUsername)
+		glSource := gitlab.NewGitLabSource(glURL, glToken, splitList(groupStr), splitList(projectStr), glUsername)
+		glSource.Rephraser = rephraser
+		sources = append(sources, glSource)
+	}
+
+	// jira
+	jToken := jiraToken
+	if jToken == "" {
+		jToken = os.Getenv("JIRA_TOKEN")
+	}
+
+	jURL := jiraURL
+	if jURL == "" {
+		jURL = os.Getenv("JIRA_URL")
+	}
+
+	if jToken != "" && jURL != "" {
+		jEmail := jiraEmail
+		if jEmail == "" {
+			jEmail = os.Getenv("JIRA_EMAIL")
+		}
+
+		jAuth := strings.ToLower(jiraAuth)
+		if jAuth == "" {
+			jAuth = strings.ToLower(os.Getenv("JIRA_AUTH"))
+		}
+		if jAuth != "" && jAuth != jira.AuthBasic && jAuth != jira.AuthBearer {
+			fmt.Printf("Invalid --jira-auth %q. Use basic or bearer.\n", jAuth)
+			return
+		}
+		if jAuth == jira.AuthBasic && jEmail == "" {
+			fmt.Println("Jira basic auth requires --jira-email")
+			return
+		}
+
+		projectStr := jiraProjects
+		if projectStr == "" {
+			projectStr = os.Getenv("JIRA_PROJECTS")
+		}
+
+		jAssignee := jiraAssignee
+		if jAssignee == "" {
+			jAssignee = os.Getenv("JIRA_ASSIGNEE")
+		}
+
+		jiraSource := jira.NewJiraSource(jURL, jToken, jEmail, jAuth, splitList(projectStr), jAssignee)
+		jiraSource.Rephraser = rephraser
+		sources = append(sources, jiraSource)
+	} else if jToken != "" {
+		fmt.Println("Jira token provided but --jira-url missing")
+	}
+
+	// linear
+	lToken := linearToken
+	if lToken == "" {
+		lToken = os.Getenv("LINEAR_API_KEY")
+	}
+
+	if lToken != "" {
+		lUser := linearUser
+		if lUser == "" {
+			lUser = os.Getenv("LINEAR_USER")
+		}
+
+		teamStr := linearTeams
+		if teamStr == "" {
+			teamStr = os.Getenv("LINEAR_TEAMS")
+		}
+
+		linearSource := linear.NewLinearSource(lToken, lUser, splitList(teamStr))
+		linearSource.Rephraser = rephraser
+		sources = append(sources, linearSource)
+	}
+
+	// local git
+	repoStr := localGitRepos
+	if repoStr == "" {
+		repoStr = os.Getenv("LOCAL_GIT_REPOS")
+	}
+
+	if repoStr != "" {
+		authorStr := localGitAuthors
+		if authorStr == "" {
+			authorStr = os.Getenv("LOCAL_GIT_AUTHORS")
+		}
+
+		branchStr := localGitBranches
+		if branchStr == "" {
+			branchStr = os.Getenv("LOCAL_GIT_BRANCHES")
+		}
+
+		gitSource := localgit.NewLocalGitSource(splitList(repoStr), splitList(authorStr), splitList(branchStr))
+		gitSource.Rephraser = rephraser
+		sources = append(sources, gitSource)
 	}
 
 	if len(sources) == 0 {
 		fmt.Println("No data sources configured. Set tokens via flags or environment variables.")
-		fmt.Println("Required: CLICKUP_API_KEY + CLICKUP_ASSIGNEE_IDS + (CLICKUP_LISTIDS or CLICKUP_FOLDERID) or GITHUB_TOKEN + GITHUB_ORGS")
+		fmt.Println("Required: CLICKUP_API_KEY + CLICKUP_ASSIGNEE_IDS + (CLICKUP_LISTIDS or CLICKUP_FOLDERID), GITHUB_TOKEN + GITHUB_ORGS, GITLAB_TOKEN, JIRA_TOKEN + JIRA_URL, LINEAR_API_KEY, or LOCAL_GIT_REPOS")
 		return
 	}
 
+	type taskNotes struct {
+		flag  string
+		notes map[string]string
+		field func(*report.Task) *string
+	}
+	var notes []taskNotes
+	for _, n := range []struct {
+		flag, value string
+		field       func(*report.Task) *string
+	}{
+		{"challenges", challenges, func(t *report.Task) *string { return &t.Challenges }},
+		{"support-required", supportRequired, func(t *report.Task) *string { return &t.SupportRequired }},
+		{"support-from", supportFrom, func(t *report.Task) *string { return &t.SupportFrom }},
+		{"follow-up", followUp, func(t *report.Task) *string { return &t.FollowUp }},
+	} {
+		parsed, err := parseTaskNotes(n.flag, n.value)
+		if err != nil {
+			fmt.Printf("Error: %v\n", err)
+			return
+		}
+		notes = append(notes, taskNotes{flag: n.flag, notes: parsed, field: n.field})
+	}
+
 	// progress bar
 	bar := newSpinner("Fetching tasks")
 	defer finishBar(bar)
 
 	gen := report.NewGenerator(sources...)
+	gen.Statuses, err = statusMap()
+	if err != nil {
+		fmt.Printf("\nError loading status map: %v\n", err)
+		return
+	}
 	tasks, err := gen.Generate(context.Background(), username, start, end)
 
 	if err != nil {
@@ -274,23 +602,10 @@ func generateReport(cmd *cobra.Command, args []string) {
 
 	fmt.Printf("Fetched %d tasks\n\n", len(tasks))
 
-	challengesList := parseCommaList(challenges)
-	supportRequiredList := parseCommaList(supportRequired)
-	supportFromList := parseCommaList(supportFrom)
-	followUpList := parseCommaList(followUp)
-
-	for i := range tasks {
-		if i < len(challengesList) {
-			tasks[i].Challenges = challengesList[i]
-		}
-		if i < len(supportRequiredList) {
-			tasks[i].SupportRequired = supportRequiredList[i]
-		}
-		if i < len(supportFromList) {
-			tasks[i].SupportFrom = supportFromList[i]
-		}
-		if i < len(followUpList) {
-			tasks[i].FollowUp = followUpList[i]
+	// the CLI notes fill fields the sources left empty on the tasks they name
+	for _, n := range notes {
+		if unmatched := applyTaskNotes(tasks, n.notes, n.field); len(unmatched) > 0 {
+			fmt.Printf("Warning: --%s names tasks not in the report: %s\n", n.flag, strings.Join(unmatched, ", "))
 		}
 	}
 
@@ -333,6 +648,7 @@ func generateReport(cmd *cobra.Command, args []string) {
 	// csv
 	if csvOutput != "" {
 		csvExporter := report.NewCSVExporter(csvOutput)
+		csvExporter.DateField = dateField
 		if err := csvExporter.Export(tasks, start, end); err != nil {
 			fmt.Printf("Failed to export CSV: %v\n", err)
 		} else {
@@ -352,6 +668,114 @@ func generateReport(cmd *cobra.Command, args []string) {
 	fmt.Printf("\nSummary:\n")
 	fmt.Printf("  Total activities: %d\n", stats["total"])
 	fmt.Printf("  Completed: %d\n", stats["completed"])
+	if merged, abandoned := stats["merged"].(int), stats["abandoned"].(int); merged > 0 || abandoned > 0 {
+		fmt.Printf("  Merged: %d, closed without merging: %d\n", merged, abandoned)
+	}
+	if reviews, ok := stats["reviews"].(int); ok && reviews > 0 {
+		fmt.Printf("  Reviews: %d\n", reviews)
+	}
+	if comments, ok := stats["comments"].(int); ok && comments > 0 {
+		fmt.Printf("  Discussion comments: %d\n", comments)
+	}
+	if metrics, ok := stats["metrics"].(report.CodeMetrics); ok && metrics.Commits > 0 {
+		fmt.Printf("  Code changes: %d commits, +%d/-%d lines, %d files\n", metrics.Commits, metrics.Additions, metrics.Deletions, metrics.FilesChanged)
+	}
+	if byCategory, ok := stats["by_category"].(map[string]int); ok {
+		var parts []string
+		for _, category := range report.Categories {
+			if n := byCategory[category]; n > 0 {
+				parts = append(parts, fmt.Sprintf("%s %d", report.CategoryLabel(category), n))
+			}
+		}
+		fmt.Printf("  By status: %s\n", strings.Join(parts, ", "))
+	}
+}
+
+// githubAppAuth returns the GitHub App installation settings from flags or
+// GITHUB_APP_* env vars, or nil when no app is configured.
+func githubAppAuth() (*github.AppAuth, error) {
+	appID := githubAppID
+	if appID == 0 {
+		appID, _ = strconv.ParseInt(os.Getenv("GITHUB_APP_ID"), 10, 64)
+	}
+
+	installationID := githubAppInstallationID
+	if installationID == 0 {
+		installationID, _ = strconv.ParseInt(os.Getenv("GITHUB_APP_INSTALLATION_ID"), 10, 64)
+	}
+
+	keyPath := githubAppPrivateKey
+	if keyPath == "" {
+		keyPath = os.Getenv("GITHUB_APP_PRIVATE_KEY_PATH")
+	}
+
+	if appID == 0 && installationID == 0 && keyPath == "" {
+		return nil, nil
+	}
+	if appID == 0 || installationID == 0 || keyPath == "" {
+		return nil, fmt.Errorf("GitHub App auth requires --github-app-id, --github-app-installation-id and --github-app-private-key")
+	}
+
+	return &github.AppAuth{
+		AppID:          appID,
+		InstallationID: installationID,
+		PrivateKeyPath: keyPath,
+	}, nil
+}
+
+// clickupTeam returns the ClickUp workspace ID from --clickup-team-id or CLICKUP_TEAM_ID.
+func clickupTeam() string {
+	if clickupTeamID != "" {
+		return clickupTeamID
+	}
+	return os.Getenv("CLICKUP_TEAM_ID")
+}
+
+// clickupFields loads the custom field map from --clickup-field-map or
+// CLICKUP_FIELD_MAP; no file means no mapping.
+func clickupFields() (clickup.FieldMap, error) {
+	path := clickupFieldMap
+	if path == "" {
+		path = os.Getenv("CLICKUP_FIELD_MAP")
+	}
+	if path == "" {
+		return nil, nil
+	}
+	return clickup.LoadFieldMap(path)
+}
+
+// llmRephraser builds the rephrasing client from the --llm-* flags or LLM_*
+// env vars. It is nil when the provider is none.
+func llmRephraser() (llm.Rephraser, error) {
+	cfg := llm.Config{
+		Provider: llmProvider,
+		URL:      llmURL,
+		Model:    llmModel,
+		APIKey:   os.Getenv("LLM_API_KEY"),
+	}
+	if cfg.Provider == "" {
+		cfg.Provider = os.Getenv("LLM_PROVIDER")
+	}
+	if cfg.URL == "" {
+		cfg.URL = os.Getenv("LLM_URL")
+	}
+	if cfg.Model == "" {
+		cfg.Model = os.Getenv("LLM_MODEL")
+	}
+	return llm.New(cfg)
+}
+
+// statusMap loads the status category overrides from --status-map or
+// STATUS_MAP; no file means statuses are categorized automatically.
+func statusMap() (report.StatusMap, error) {
+	path := statusMapFile
+	if path == "" {
+		path = os.Getenv("STATUS_MAP")
+	}
+	if path == "" {
+		return nil, nil
+	}
+	return report.LoadStatusMap(path)
 }
 
 func generateSummary(cmd *cobra.Command, args []string) {
@@ -369,24 +793,33 @@ func generateSummary(cmd *cobra.Command, args []string) {
 	var err error
 	var listNames map[string]string
 
-	if folderID != "" {
+	refs := clickupRefs{
+		lists:     splitList(clickupListIDs),
+		folder:    strings.TrimSpace(folderID),
+		assignees: splitList(clickUpAssignees),
+	}
+	if token != "" {
+		if err := refs.resolve(token); err != nil {
+			fmt.Printf("Error: %v\n", err)
+			return
+		}
+	}
+
+	if refs.folder != "" {
 		bar := newSpinner("Fetching lists from folder")
 		defer finishBar(bar)
 
 		client := clickup.NewClient(token, nil, nil)
-		listIDs, listNames, err = client.GetListIDsAndNamesFromFolder(folderID)
+		listIDs, listNames, err = client.GetListIDsAndNamesFromFolder(refs.folder)
 
 		if err != nil {
 			fmt.Printf("\nError fetching lists from folder: %v\n", err)
 			return
 		}
 		fmt.Printf("Found %d lists in folder\n\n", len(listIDs))
-	} else if clickupListIDs != "" {
-		listIDs = strings.Split(clickupListIDs, ",")
-		for i := range listIDs {
-			listIDs[i] = strings.TrimSpace(listIDs[i])
-		}
-		listNames = make(map[string]string)
+	} else if len(refs.lists) > 0 {
+		listIDs = refs.lists
+		listNames = refs.listNames
 	}
 
 	if token == "" || len(listIDs) == 0 {
@@ -437,16 +870,29 @@ func generateSummary(cmd *cobra.Command, args []string) {
 		return
 	}
 
+	dateField, err := report.ParseDateField(dateFieldFlag)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
 	fmt.Printf("Generating team summary for: %s -> %s\n", start.Format("2006-01-02"), end.Format("2006-01-02"))
 
-	var assigneeIDs []string
-	if clickUpAssignees != "" {
-		assigneeIDs = strings.Split(clickUpAssignees, ",")
-		for i := range assigneeIDs {
-			assigneeIDs[i] = strings.TrimSpace(assigneeIDs[i])
-		}
+	source := clickup.NewClickUpSource(token, listIDs, refs.assignees, "")
+	source.TrackTime = clickupTimeTracking
+	source.TeamID = clickupTeam()
+	source.ReadComments = clickupComments
+	source.DateField = dateField
+	source.Rephraser, err = llmRephraser()
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+	source.Fields, err = clickupFields()
+	if err != nil {
+		fmt.Printf("Error loading ClickUp field map: %v\n", err)
+		return
 	}
-	source := clickup.NewClickUpSource(token, listIDs, assigneeIDs, "")
 
 	source.Client.SetListNames(listNames)
 
@@ -454,6 +900,11 @@ func generateSummary(cmd *cobra.Command, args []string) {
 	defer finishBar(bar)
 
 	gen := report.NewGenerator(source)
+	gen.Statuses, err = statusMap()
+	if err != nil {
+		fmt.Printf("\nError loading status map: %v\n", err)
+		return
+	}
 	tasks, err := gen.Generate(context.Background(), "", start, end)
 
 	if err != nil {
@@ -477,6 +928,7 @@ func generateSummary(cmd *cobra.Command, args []string) {
 	// fmt.Printf("  -> %s/summary_*_dashboard.csv\n", csvOutput)
 	//
 	excelExporter := report.NewExcelExporter(csvOutput)
+	excelExporter.DateField = dateField
 	if err := excelExporter.Export(tasks, start, end); err != nil {
 		fmt.Printf("\nExcel export failed: %v\n", err)
 		return
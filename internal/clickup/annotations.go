@@ -0,0 +1,142 @@
+package clickup
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/Afrawles/devreport/internal/report"
+)
+
+// annotationTags maps the tags recognised at the start of a comment line or
+// checklist item to the report field the rest of the line fills.
+var annotationTags = map[string]string{
+	"#challenge":  FieldChallenges,
+	"#challenges": FieldChallenges,
+	"#support":    FieldSupportRequired,
+	"#blocked-by": FieldSupportFrom,
+	"#blockedby":  FieldSupportFrom,
+	"#followup":   FieldFollowUp,
+	"#follow-up":  FieldFollowUp,
+}
+
+// annotations holds tagged entries per report field.
+type annotations map[string][]string
+
+// parseAnnotation returns the report field and entry of a tagged line such as
+// "#challenge: flaky staging environment".
+func parseAnnotation(line string) (string, string, bool) {
+	line = strings.TrimSpace(line)
+	if !strings.HasPrefix(line, "#") {
+		return "", "", false
+	}
+
+	tag, rest := line, ""
+	if i := strings.IndexAny(line, " \t:"); i >= 0 {
+		tag, rest = line[:i], line[i:]
+	}
+	field, ok := annotationTags[strings.ToLower(tag)]
+	if !ok {
+		return "", "", false
+	}
+
+	entry := strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(rest), ":"))
+	if entry == "" {
+		return "", "", false
+	}
+	return field, entry, true
+}
+
+// add collects the tagged lines of text.
+func (a annotations) add(text string) {
+	for _, line := range strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n") {
+		if field, entry, ok := parseAnnotation(line); ok {
+			a[field] = append(a[field], entry)
+		}
+	}
+}
+
+// apply writes the entries into the task, after anything already there.
+func (a annotations) apply(task *report.Task) {
+	for field, entries := range a {
+		var target *string
+		switch field {
+		case FieldChallenges:
+			target = &task.Challenges
+		case FieldSupportRequired:
+			target = &task.SupportRequired
+		case FieldSupportFrom:
+			target = &task.SupportFrom
+		case FieldFollowUp:
+			target = &task.FollowUp
+		default:
+			continue
+		}
+
+		text := entries[0]
+		if len(entries) > 1 {
+			bullets := make([]string, len(entries))
+			for i, entry := range entries {
+				bullets[i] = fmt.Sprintf("• %s", entry)
+			}
+			text = strings.Join(bullets, "\n")
+		}
+
+		if *target != "" {
+			*target += "\n" + text
+		} else {
+			*target = text
+		}
+	}
+}
+
+// taskAnnotations reads tagged entries from the checklists of the given tasks and,
+// when enabled, from their comments, which costs one request per task.
+func (c *ClickUpSource) taskAnnotations(tasks []ClickUpTask) map[string]annotations {
+	byTask := make(map[string]annotations, len(tasks))
+	for _, t := range tasks {
+		if _, ok := byTask[t.ID]; ok {
+			continue
+		}
+		notes := make(annotations)
+		for _, checklist := range t.Checklists {
+			for _, item := range checklist.Items {
+				notes.add(item.Name)
+			}
+		}
+		byTask[t.ID] = notes
+	}
+
+	if !c.ReadComments {
+		return byTask
+	}
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	ids := make(chan string)
+	for i := 0; i < defaultMaxWorkers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for id := range ids {
+				comments, err := c.Client.FetchTaskComments(id)
+				if err != nil {
+					fmt.Printf("Warning: could not fetch comments for task %s: %v\n", id, err)
+					continue
+				}
+				mu.Lock()
+				for _, comment := range comments {
+					byTask[id].add(comment.CommentText)
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+	for id := range byTask {
+		ids <- id
+	}
+	close(ids)
+	wg.Wait()
+
+	return byTask
+}
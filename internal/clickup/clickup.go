@@ -1,16 +1,32 @@
 package clickup
 
 import (
+	"fmt"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/Afrawles/devreport/internal/llm"
 	"github.com/Afrawles/devreport/internal/report"
 )
 
 type ClickUpSource struct {
 	Client   *Client
 	Category string
+	// TrackTime attaches tracked time entries to tasks.
+	TrackTime bool
+	// TeamID is the workspace whose time entries are read. When empty, the
+	// token's only workspace is used.
+	TeamID string
+	// Fields maps custom fields onto report fields.
+	Fields FieldMap
+	// ReadComments also reads tagged entries (#challenge, #support,
+	// #blocked-by, #followup) from task comments, not just checklists.
+	ReadComments bool
+	// DateField selects which task date must fall in the report period.
+	DateField report.DateField
+	// Rephraser rewrites task descriptions as achievements; nil keeps them as written.
+	Rephraser llm.Rephraser
 }
 
 func NewClickUpSource(apiKey string, listID, assigneeIDs []string, category string) *ClickUpSource {
@@ -31,12 +47,22 @@ func (c *ClickUpSource) HealthCheck() error {
 }
 
 func (c *ClickUpSource) FetchTasks(user string, start, end time.Time) ([]report.Task, error) {
+	c.Client.SetDateField(c.DateField)
 	clickupTasks, err := c.Client.FetchTasks(c.Client.listID, start, end, len(c.Client.listID))
 	if err != nil {
 		return nil, err
 	}
 
+	var timeByTask map[string]map[string]time.Duration
+	if c.TrackTime {
+		timeByTask, err = c.trackedTime(start, end)
+		if err != nil {
+			fmt.Printf("Warning: could not fetch ClickUp time entries: %v\n", err)
+		}
+	}
+
 	var allTasks []report.Task
+	var kept []ClickUpTask
 
 	for _, t := range clickupTasks {
 		createdMs, _ := strconv.ParseInt(t.DateCreated, 10, 64)
@@ -45,11 +71,26 @@ func (c *ClickUpSource) FetchTasks(user string, start, end time.Time) ([]report.
 		createdAt := time.UnixMilli(createdMs)
 		updatedAt := time.UnixMilli(updatedMs)
 
-		var completedAt *time.Time
-		if t.DateClosed != nil {
-			closedMs, _ := strconv.ParseInt(*t.DateClosed, 10, 64)
-			closed := time.UnixMilli(closedMs)
-			completedAt = &closed
+		// tasks moved to a done status are finished even if never closed
+		completedAt := parseMillis(t.DateClosed)
+		if completedAt == nil {
+			completedAt = parseMillis(t.DateDone)
+		}
+		dueDate := parseMillis(t.DueDate)
+
+		priority := ""
+		if t.Priority != nil {
+			priority = t.Priority.Priority
+		}
+
+		var labels []string
+		for _, tag := range t.Tags {
+			labels = append(labels, tag.Name)
+		}
+
+		var estimate time.Duration
+		if t.TimeEstimate != nil {
+			estimate = time.Duration(*t.TimeEstimate) * time.Millisecond
 		}
 
 		var assigneeNames []string
@@ -66,28 +107,108 @@ func (c *ClickUpSource) FetchTasks(user string, start, end time.Time) ([]report.
 			projectName = t.List.Name
 		}
 
-		rephrased := rephraseTask(t.Description)
 		task := report.Task{
-			ID:              t.ID,
-			Title:           t.Name,
-			Description:     t.Description,
-			Achievements:    rephrased,
-			Status:          t.Status.Status,
-			URL:             t.URL,
-			CreatedAt:       createdAt,
-			UpdatedAt:       updatedAt,
-			CompletedAt:     completedAt,
-			Source:          projectName,
-			Type:            "Task",
-			Assignee:        assignee,
-			Challenges:      "",
-			SupportRequired: "",
-			SupportFrom:     "",
-			FollowUp:        "",
+			ID:          t.ID,
+			Title:       t.Name,
+			Description: t.Description,
+			Status:      t.Status.Status,
+			URL:         t.URL,
+			CreatedAt:   createdAt,
+			UpdatedAt:   updatedAt,
+			CompletedAt: completedAt,
+			DueDate:     dueDate,
+			Priority:    priority,
+			Labels:      labels,
+			Estimate:    estimate,
+			TimeSpentBy: timeByTask[t.ID],
+			Source:      projectName,
+			Type:        "Task",
+			Assignee:    assignee,
+		}
+		task.StatusCategory = statusCategory(t.Status, completedAt != nil)
+		if t.Parent != nil {
+			task.ParentID = *t.Parent
+		}
+		if !task.InPeriod(c.DateField, start, end) {
+			continue
+		}
+
+		task.Achievements = c.rephraseTask(t.Description)
+		c.Fields.apply(&task, t.CustomFields)
+
+		for _, d := range task.TimeSpentBy {
+			task.TimeSpent += d
 		}
 
 		allTasks = append(allTasks, task)
+		kept = append(kept, t)
+	}
+
+	// only tasks in the period are annotated, so comments are not fetched for the rest
+	notes := c.taskAnnotations(kept)
+	for i := range allTasks {
+		notes[allTasks[i].ID].apply(&allTasks[i])
 	}
 
 	return allTasks, nil
 }
+
+// trackedTime totals the finished time entries in the window per task and person.
+func (c *ClickUpSource) trackedTime(start, end time.Time) (map[string]map[string]time.Duration, error) {
+	teamID := c.TeamID
+	if teamID == "" {
+		teams, err := c.Client.FetchTeams()
+		if err != nil {
+			return nil, err
+		}
+		if len(teams) != 1 {
+			return nil, fmt.Errorf("token has access to %d workspaces, set --clickup-team-id", len(teams))
+		}
+		teamID = teams[0].ID
+	}
+
+	entries, err := c.Client.FetchTimeEntries(teamID, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	byTask := make(map[string]map[string]time.Duration)
+	for _, entry := range entries {
+		taskID := entry.TaskID()
+		ms, err := strconv.ParseInt(entry.Duration, 10, 64)
+		if taskID == "" || err != nil || ms <= 0 {
+			continue
+		}
+		if byTask[taskID] == nil {
+			byTask[taskID] = make(map[string]time.Duration)
+		}
+		byTask[taskID][entry.User.Username] += time.Duration(ms) * time.Millisecond
+	}
+
+	return byTask, nil
+}
+
+// statusCategory maps a ClickUp status to a category by its type. Custom
+// statuses are told apart by name.
+func statusCategory(status ClickUpStatus, completed bool) string {
+	switch status.Type {
+	case "open":
+		return report.CategoryTodo
+	case "done", "closed":
+		return report.CategoryDone
+	}
+	return report.GuessCategory(status.Status, completed)
+}
+
+// parseMillis parses a ClickUp millisecond timestamp, which is null or empty when unset.
+func parseMillis(value *string) *time.Time {
+	if value == nil || *value == "" {
+		return nil
+	}
+	ms, err := strconv.ParseInt(*value, 10, 64)
+	if err != nil {
+		return nil
+	}
+	t := time.UnixMilli(ms)
+	return &t
+}
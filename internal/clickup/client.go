@@ -8,9 +8,11 @@ import (
 	"math"
 	"net/http"
 	"net/url"
+	"strings"
 	"sync"
 	"time"
 
+	"github.com/Afrawles/devreport/internal/report"
 	"golang.org/x/time/rate"
 )
 
@@ -31,6 +33,7 @@ type Client struct {
 	limiter     *rate.Limiter
 	listID      []string
 	listNames   map[string]string
+	dateField   report.DateField
 }
 
 func NewClient(apiKey string, listID, assigneeIDs []string) *Client {
@@ -59,6 +62,11 @@ func (c *Client) SetListNames(names map[string]string) {
 	c.listNames = names
 }
 
+// SetDateField selects the task date that FetchTasksForList filters on.
+func (c *Client) SetDateField(field report.DateField) {
+	c.dateField = field
+}
+
 type ClickUpTask struct {
 	ID          string        `json:"id"`
 	Name        string        `json:"name"`
@@ -68,12 +76,52 @@ type ClickUpTask struct {
 	DateCreated string        `json:"date_created"`
 	DateUpdated string        `json:"date_updated"`
 	DateClosed  *string       `json:"date_closed"`
-	Assignees   []Assignee    `json:"assignees"`
-	List        ListInfo      `json:"list"`
+	DateDone    *string       `json:"date_done"`
+	DueDate     *string       `json:"due_date"`
+	Priority    *Priority     `json:"priority"`
+	Tags        []Tag         `json:"tags"`
+	// TimeEstimate is in milliseconds.
+	TimeEstimate *int64        `json:"time_estimate"`
+	Assignees    []Assignee    `json:"assignees"`
+	List         ListInfo      `json:"list"`
+	CustomFields []CustomField `json:"custom_fields"`
+	Checklists   []Checklist   `json:"checklists"`
+	// Parent is the parent task ID for subtasks.
+	Parent *string `json:"parent"`
+}
+
+type Checklist struct {
+	ID    string          `json:"id"`
+	Name  string          `json:"name"`
+	Items []ChecklistItem `json:"items"`
+}
+
+type ChecklistItem struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	Resolved bool   `json:"resolved"`
 }
 
+type Comment struct {
+	ID          string   `json:"id"`
+	CommentText string   `json:"comment_text"`
+	User        Assignee `json:"user"`
+	Date        string   `json:"date"`
+}
+
+type Priority struct {
+	ID       string `json:"id"`
+	Priority string `json:"priority"`
+}
+
+type Tag struct {
+	Name string `json:"name"`
+}
+
+// ClickUpStatus is a task status. Type is open, custom, done or closed.
 type ClickUpStatus struct {
 	Status string `json:"status"`
+	Type   string `json:"type"`
 }
 
 type Assignee struct {
@@ -107,6 +155,43 @@ type TasksResponse struct {
 	Tasks []ClickUpTask `json:"tasks"`
 }
 
+type Team struct {
+	ID      string       `json:"id"`
+	Name    string       `json:"name"`
+	Members []TeamMember `json:"members"`
+}
+
+type TeamMember struct {
+	User Member `json:"user"`
+}
+
+type Member struct {
+	ID       int    `json:"id"`
+	Username string `json:"username"`
+	Email    string `json:"email"`
+}
+
+// TimeEntry is a tracked time interval. Task is null for entries not
+// attached to a task, and Duration is negative while a timer is running.
+type TimeEntry struct {
+	ID       string          `json:"id"`
+	Task     json.RawMessage `json:"task"`
+	User     Assignee        `json:"user"`
+	Duration string          `json:"duration"`
+	Start    string          `json:"start"`
+}
+
+// TaskID returns the ID of the task the entry was tracked against, if any.
+func (e TimeEntry) TaskID() string {
+	var task struct {
+		ID string `json:"id"`
+	}
+	if err := json.Unmarshal(e.Task, &task); err != nil {
+		return ""
+	}
+	return task.ID
+}
+
 // FetchListDetails fetches details for a specific list
 func (c *Client) FetchListDetails(listID string) (*ListDetails, error) {
 	url := fmt.Sprintf("%s/list/%s", baseURL, listID)
@@ -162,11 +247,22 @@ func (c *Client) FetchTasksForList(listID string, start, end time.Time) ([]Click
 			}
 		}
 
+		// any activity in the window leaves the task updated after start, so
+		// "any" only bounds the update date below; callers check the window
+		param, bounded := "date_created", true
+		switch c.dateField {
+		case report.DateUpdated:
+			param = "date_updated"
+		case report.DateClosed:
+			param = "date_done"
+		case report.DateAny:
+			param, bounded = "date_updated", false
+		}
 		if !start.IsZero() {
-			q.Add("date_created_gt", fmt.Sprintf("%d", start.UnixMilli()))
+			q.Add(param+"_gt", fmt.Sprintf("%d", start.UnixMilli()))
 		}
-		if !end.IsZero() {
-			q.Add("date_created_lt", fmt.Sprintf("%d", end.UnixMilli()))
+		if !end.IsZero() && bounded {
+			q.Add(param+"_lt", fmt.Sprintf("%d", end.UnixMilli()))
 		}
 
 		req, err := http.NewRequest("GET", base+"?"+q.Encode(), nil)
@@ -257,6 +353,106 @@ func (c *Client) FetchTasks(listIDs []string, start, end time.Time, maxWorkers i
 	return allTasks, nil
 }
 
+// FetchTaskComments fetches the comments on a task
+func (c *Client) FetchTaskComments(taskID string) ([]Comment, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/task/%s/comment", baseURL, taskID), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", c.apiKey)
+
+	resp, err := c.doWithRetry(req)
+	if err != nil {
+		return nil, fmt.Errorf("request failed after retries: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error %d: %s", resp.StatusCode, string(body))
+	}
+
+	var result struct {
+		Comments []Comment `json:"comments"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	return result.Comments, nil
+}
+
+// FetchTeams fetches the workspaces (teams) the token has access to
+func (c *Client) FetchTeams() ([]Team, error) {
+	req, err := http.NewRequest("GET", baseURL+"/team", nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", c.apiKey)
+
+	resp, err := c.doWithRetry(req)
+	if err != nil {
+		return nil, fmt.Errorf("request failed after retries: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error %d: %s", resp.StatusCode, string(body))
+	}
+
+	var result struct {
+		Teams []Team `json:"teams"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	return result.Teams, nil
+}
+
+// FetchTimeEntries fetches time entries tracked in the team within the window,
+// filtered by the client's assignees. Without assignees ClickUp returns only
+// the token owner's entries.
+func (c *Client) FetchTimeEntries(teamID string, start, end time.Time) ([]TimeEntry, error) {
+	q := url.Values{}
+	if !start.IsZero() {
+		q.Add("start_date", fmt.Sprintf("%d", start.UnixMilli()))
+	}
+	if !end.IsZero() {
+		q.Add("end_date", fmt.Sprintf("%d", end.UnixMilli()))
+	}
+	if len(c.assigneeIDs) > 0 {
+		q.Add("assignee", strings.Join(c.assigneeIDs, ","))
+	}
+
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/team/%s/time_entries?%s", baseURL, teamID, q.Encode()), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", c.apiKey)
+
+	resp, err := c.doWithRetry(req)
+	if err != nil {
+		return nil, fmt.Errorf("request failed after retries: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error %d: %s", resp.StatusCode, string(body))
+	}
+
+	var result struct {
+		Data []TimeEntry `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	return result.Data, nil
+}
+
 func (c *Client) HealthCheck() error {
 	req, err := http.NewRequest("GET", baseURL+"/user", nil)
 	if err != nil {
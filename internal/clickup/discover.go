@@ -0,0 +1,262 @@
+package clickup
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// Workspace is a ClickUp team with its members and space hierarchy.
+type Workspace struct {
+	ID      string   `json:"id"`
+	Name    string   `json:"name"`
+	Members []Member `json:"members"`
+	Spaces  []Space  `json:"spaces"`
+}
+
+type Space struct {
+	ID      string   `json:"id"`
+	Name    string   `json:"name"`
+	Folders []Folder `json:"folders"`
+	// Lists are the lists that sit directly in the space, outside any folder.
+	Lists []List `json:"lists"`
+}
+
+type Folder struct {
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	Lists []List `json:"lists"`
+}
+
+type List struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// Workspaces is the discovered hierarchy, used to resolve names to IDs.
+type Workspaces []Workspace
+
+// Discover walks the workspaces the token can see, down to their lists. When
+// teamID is set only that workspace is walked. Archived spaces, folders and
+// lists are skipped.
+func (c *Client) Discover(teamID string) (Workspaces, error) {
+	teams, err := c.FetchTeams()
+	if err != nil {
+		return nil, err
+	}
+
+	var workspaces Workspaces
+	for _, team := range teams {
+		if teamID != "" && team.ID != teamID {
+			continue
+		}
+
+		ws := Workspace{ID: team.ID, Name: team.Name}
+		for _, m := range team.Members {
+			ws.Members = append(ws.Members, m.User)
+		}
+
+		spaces, err := c.FetchSpaces(team.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch spaces of %s: %w", team.Name, err)
+		}
+		for _, space := range spaces {
+			space.Folders, err = c.FetchFolders(space.ID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to fetch folders of %s: %w", space.Name, err)
+			}
+			space.Lists, err = c.FetchFolderlessLists(space.ID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to fetch lists of %s: %w", space.Name, err)
+			}
+			ws.Spaces = append(ws.Spaces, space)
+		}
+
+		workspaces = append(workspaces, ws)
+	}
+
+	if teamID != "" && len(workspaces) == 0 {
+		return nil, fmt.Errorf("workspace %s not found", teamID)
+	}
+
+	return workspaces, nil
+}
+
+// FetchSpaces fetches the spaces of a team
+func (c *Client) FetchSpaces(teamID string) ([]Space, error) {
+	var result struct {
+		Spaces []Space `json:"spaces"`
+	}
+	if err := c.getJSON(fmt.Sprintf("/team/%s/space", teamID), &result); err != nil {
+		return nil, err
+	}
+	return result.Spaces, nil
+}
+
+// FetchFolders fetches the folders of a space, including their lists
+func (c *Client) FetchFolders(spaceID string) ([]Folder, error) {
+	var result struct {
+		Folders []Folder `json:"folders"`
+	}
+	if err := c.getJSON(fmt.Sprintf("/space/%s/folder", spaceID), &result); err != nil {
+		return nil, err
+	}
+	return result.Folders, nil
+}
+
+// FetchFolderlessLists fetches the lists that sit directly in a space
+func (c *Client) FetchFolderlessLists(spaceID string) ([]List, error) {
+	var result struct {
+		Lists []List `json:"lists"`
+	}
+	if err := c.getJSON(fmt.Sprintf("/space/%s/list", spaceID), &result); err != nil {
+		return nil, err
+	}
+	return result.Lists, nil
+}
+
+// getJSON fetches a non-archived collection and decodes it into v.
+func (c *Client) getJSON(path string, v any) error {
+	q := url.Values{}
+	q.Add("archived", "false")
+
+	req, err := http.NewRequest("GET", baseURL+path+"?"+q.Encode(), nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", c.apiKey)
+
+	resp, err := c.doWithRetry(req)
+	if err != nil {
+		return fmt.Errorf("request failed after retries: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("API error %d: %s", resp.StatusCode, string(body))
+	}
+
+	return json.NewDecoder(resp.Body).Decode(v)
+}
+
+// IsID reports whether ref is a ClickUp ID rather than a name. List, folder
+// and user IDs are numeric.
+func IsID(ref string) bool {
+	_, err := strconv.ParseUint(ref, 10, 64)
+	return err == nil
+}
+
+// ListIDs resolves list names to IDs. IDs are passed through unchanged.
+func (w Workspaces) ListIDs(refs []string) ([]string, error) {
+	var lists []List
+	for _, ws := range w {
+		for _, space := range ws.Spaces {
+			for _, folder := range space.Folders {
+				lists = append(lists, folder.Lists...)
+			}
+			lists = append(lists, space.Lists...)
+		}
+	}
+
+	ids := make([]string, len(refs))
+	for i, ref := range refs {
+		if IsID(ref) {
+			ids[i] = ref
+			continue
+		}
+		var matches []string
+		for _, l := range lists {
+			if strings.EqualFold(l.Name, ref) {
+				matches = append(matches, l.ID)
+			}
+		}
+		id, err := resolveOne("list", ref, matches)
+		if err != nil {
+			return nil, err
+		}
+		ids[i] = id
+	}
+	return ids, nil
+}
+
+// FolderID resolves a folder name to its ID. IDs are passed through unchanged.
+func (w Workspaces) FolderID(ref string) (string, error) {
+	if IsID(ref) {
+		return ref, nil
+	}
+	var matches []string
+	for _, ws := range w {
+		for _, space := range ws.Spaces {
+			for _, folder := range space.Folders {
+				if strings.EqualFold(folder.Name, ref) {
+					matches = append(matches, folder.ID)
+				}
+			}
+		}
+	}
+	return resolveOne("folder", ref, matches)
+}
+
+// MemberIDs resolves usernames or emails to user IDs. IDs are passed through unchanged.
+func (w Workspaces) MemberIDs(refs []string) ([]string, error) {
+	ids := make([]string, len(refs))
+	for i, ref := range refs {
+		if IsID(ref) {
+			ids[i] = ref
+			continue
+		}
+		seen := make(map[int]bool)
+		var matches []string
+		for _, ws := range w {
+			for _, m := range ws.Members {
+				if seen[m.ID] {
+					continue
+				}
+				if strings.EqualFold(m.Username, ref) || strings.EqualFold(m.Email, ref) {
+					seen[m.ID] = true
+					matches = append(matches, strconv.Itoa(m.ID))
+				}
+			}
+		}
+		id, err := resolveOne("member", ref, matches)
+		if err != nil {
+			return nil, err
+		}
+		ids[i] = id
+	}
+	return ids, nil
+}
+
+// ListNames maps every discovered list ID to its name.
+func (w Workspaces) ListNames() map[string]string {
+	names := make(map[string]string)
+	for _, ws := range w {
+		for _, space := range ws.Spaces {
+			for _, folder := range space.Folders {
+				for _, l := range folder.Lists {
+					names[l.ID] = l.Name
+				}
+			}
+			for _, l := range space.Lists {
+				names[l.ID] = l.Name
+			}
+		}
+	}
+	return names
+}
+
+func resolveOne(kind, ref string, matches []string) (string, error) {
+	switch len(matches) {
+	case 0:
+		return "", fmt.Errorf("no ClickUp %s named %q", kind, ref)
+	case 1:
+		return matches[0], nil
+	default:
+		return "", fmt.Errorf("ClickUp %s name %q is ambiguous (IDs %s), use the ID instead", kind, ref, strings.Join(matches, ", "))
+	}
+}
@@ -0,0 +1,193 @@
+package clickup
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Afrawles/devreport/internal/report"
+)
+
+// Report fields a ClickUp custom field can be mapped to.
+const (
+	FieldProject         = "project"
+	FieldAttachmentURL   = "attachment_url"
+	FieldChallenges      = "challenges"
+	FieldSupportRequired = "support_required"
+	FieldSupportFrom     = "support_from"
+	FieldFollowUp        = "follow_up"
+	FieldLabels          = "labels"
+	FieldPriority        = "priority"
+	FieldDueDate         = "due_date"
+)
+
+var reportFields = map[string]bool{
+	FieldProject:         true,
+	FieldAttachmentURL:   true,
+	FieldChallenges:      true,
+	FieldSupportRequired: true,
+	FieldSupportFrom:     true,
+	FieldFollowUp:        true,
+	FieldLabels:          true,
+	FieldPriority:        true,
+	FieldDueDate:         true,
+}
+
+// FieldMap binds ClickUp custom field names to report fields, e.g.
+// {"Client": "project", "Evidence link": "attachment_url"}.
+type FieldMap map[string]string
+
+// LoadFieldMap reads a FieldMap from a JSON file.
+func LoadFieldMap(path string) (FieldMap, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read field map: %w", err)
+	}
+
+	var fields FieldMap
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, fmt.Errorf("failed to parse field map %s: %w", path, err)
+	}
+
+	for name, target := range fields {
+		if !reportFields[target] {
+			return nil, fmt.Errorf("field map %s: unknown report field %q for %q", path, target, name)
+		}
+	}
+
+	return fields, nil
+}
+
+type CustomField struct {
+	ID         string          `json:"id"`
+	Name       string          `json:"name"`
+	Type       string          `json:"type"`
+	TypeConfig FieldTypeConfig `json:"type_config"`
+	Value      json.RawMessage `json:"value"`
+}
+
+type FieldTypeConfig struct {
+	Options []FieldOption `json:"options"`
+}
+
+// FieldOption is a drop_down or labels option. Drop-down options are named,
+// label options carry a label instead.
+type FieldOption struct {
+	ID         string          `json:"id"`
+	Name       string          `json:"name"`
+	Label      string          `json:"label"`
+	OrderIndex json.RawMessage `json:"orderindex"`
+}
+
+func (o FieldOption) text() string {
+	if o.Name != "" {
+		return o.Name
+	}
+	return o.Label
+}
+
+// Values decodes the field value into display strings. Unset fields have none;
+// labels fields may have several.
+func (f CustomField) Values() []string {
+	if len(f.Value) == 0 || string(f.Value) == "null" {
+		return nil
+	}
+
+	switch f.Type {
+	case "drop_down":
+		// the value is the option's orderindex, or its ID on newer workspaces
+		raw := strings.Trim(string(f.Value), `"`)
+		for _, opt := range f.TypeConfig.Options {
+			if opt.ID == raw || strings.Trim(string(opt.OrderIndex), `"`) == raw {
+				return []string{opt.text()}
+			}
+		}
+		return nil
+	case "labels":
+		var ids []string
+		if err := json.Unmarshal(f.Value, &ids); err != nil {
+			return nil
+		}
+		var values []string
+		for _, id := range ids {
+			for _, opt := range f.TypeConfig.Options {
+				if opt.ID == id {
+					values = append(values, opt.text())
+				}
+			}
+		}
+		return values
+	case "date":
+		if t := f.Time(); t != nil {
+			return []string{t.Format("2006-01-02")}
+		}
+		return nil
+	}
+
+	// text, url, email, number, currency and the like hold a string or a number
+	var s string
+	if err := json.Unmarshal(f.Value, &s); err == nil {
+		s = strings.TrimSpace(s)
+		if s == "" {
+			return nil
+		}
+		return []string{s}
+	}
+	var n json.Number
+	if err := json.Unmarshal(f.Value, &n); err == nil {
+		return []string{n.String()}
+	}
+	return nil
+}
+
+// Time decodes a date field, stored as a millisecond timestamp.
+func (f CustomField) Time() *time.Time {
+	raw := strings.Trim(string(f.Value), `"`)
+	ms, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil {
+		return nil
+	}
+	t := time.UnixMilli(ms)
+	return &t
+}
+
+// apply copies the mapped custom field values into the task.
+func (m FieldMap) apply(task *report.Task, fields []CustomField) {
+	for _, field := range fields {
+		target, ok := m[field.Name]
+		if !ok {
+			continue
+		}
+		values := field.Values()
+		if len(values) == 0 {
+			continue
+		}
+		text := strings.Join(values, ", ")
+
+		switch target {
+		case FieldProject:
+			task.Source = text
+		case FieldAttachmentURL:
+			task.AttachmentURL = text
+		case FieldChallenges:
+			task.Challenges = text
+		case FieldSupportRequired:
+			task.SupportRequired = text
+		case FieldSupportFrom:
+			task.SupportFrom = text
+		case FieldFollowUp:
+			task.FollowUp = text
+		case FieldLabels:
+			task.Labels = append(task.Labels, values...)
+		case FieldPriority:
+			task.Priority = text
+		case FieldDueDate:
+			if field.Type == "date" {
+				task.DueDate = field.Time()
+			}
+		}
+	}
+}
@@ -0,0 +1,37 @@
+package clickup
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Afrawles/devreport/internal/llm"
+)
+
+// rephraseTask takes a ClickUp task description and rephrases it as a professional achievement.
+func (c *ClickUpSource) rephraseTask(taskDescription string) string {
+	if c.Rephraser == nil || strings.TrimSpace(taskDescription) == "" {
+		return taskDescription
+	}
+
+	prompt := llm.Prompt{
+		Subject: "task description",
+		Label:   "Original description",
+		Rules: []string{
+			"Use strong action verbs and focus on the accomplishment",
+			"For currency: Add 'UGX' prefix to numbers that represent money (e.g., '5000' becomes 'UGX 5000')",
+			"PRESERVE all numerical values EXACTLY as written - do not modify, round, or change any numbers",
+			"Only fix spelling errors and grammar mistakes",
+			"Do NOT change the core meaning or description of the task",
+			"Return only the rephrased text without bullet point symbols (•, -, *)",
+		},
+	}.Build(taskDescription)
+
+	rephrased, err := c.Rephraser.Rephrase(prompt)
+	if err != nil {
+		fmt.Printf("LLM unavailable for task rephrase: %v\n", err)
+		return taskDescription
+	}
+
+	fmt.Printf("Successfully rephrased task: %s -> %s\n", taskDescription, rephrased)
+	return rephrased
+}
@@ -4,48 +4,173 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"net/http"
+	"net/url"
+	"path"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/Afrawles/devreport/internal/report"
 	"github.com/google/go-github/v60/github"
 	"golang.org/x/oauth2"
 )
 
+// Options configures what the GitHub client collects.
+type Options struct {
+	Token                 string
+	Orgs                  []string
+	Repos                 []string
+	Username              string
+	IncludeReviewedPRs    bool
+	IncludeAssignedIssues bool
+	// IncludeCommits adds commits pushed directly to the default branches,
+	// or to the branches matching Branches, outside of any PR.
+	IncludeCommits bool
+	// IncludeComments adds issues and PRs the user commented on as discussions.
+	IncludeComments bool
+	// IncludeReleases adds releases the user published in the covered repositories.
+	IncludeReleases bool
+	// IncludeTags adds tags the user created, as recorded in their event feed.
+	IncludeTags bool
+	// Branches restricts PRs to those targeting matching base branches.
+	// Entries may be names or path.Match globs such as release/*.
+	Branches []string
+	// API selects the REST (default) or GraphQL backend for PRs, reviews and issues.
+	API string
+	// Concurrency bounds how many repositories and PRs are fetched in parallel.
+	Concurrency int
+	// App authenticates as a GitHub App installation; it takes precedence over Token.
+	App *AppAuth
+	// BaseURL points the client at a GitHub Enterprise Server instance,
+	// e.g. https://github.example.com or https://github.example.com/api/v3.
+	BaseURL string
+	// DateField selects which date of a PR or issue must fall in the range.
+	// The GraphQL backend only reports by creation date, so other fields
+	// fetch PRs and issues over REST.
+	DateField report.DateField
+}
+
+const defaultConcurrency = 4
+
 type Client struct {
 	client                *github.Client
+	httpClient            *http.Client
+	graphqlURL            string
+	api                   string
 	orgs                  []string
 	repos                 []string
 	username              string
 	includeReviewedPRs    bool
 	includeAssignedIssues bool
+	includeCommits        bool
+	includeComments       bool
+	includeReleases       bool
+	includeTags           bool
+	branches              []string
+	dateField             report.DateField
+	concurrency           int
 	repoCache             map[string][]*github.Repository
 }
 
-func NewClient(token string, orgs []string, repos []string, username string, includeReviewedPRs, includeAssignedIssues bool) *Client {
-	var tc oauth2.TokenSource
-	if token != "" {
-		tc = oauth2.StaticTokenSource(&oauth2.Token{AccessToken: token})
-	}
-	httpClient := oauth2.NewClient(context.Background(), tc)
+func NewClient(opts Options) (*Client, error) {
+	transport := &budgetTransport{base: http.DefaultTransport, budget: newRateBudget()}
+	httpClient := &http.Client{Transport: transport}
 	client := github.NewClient(httpClient)
 
+	graphqlURL := defaultGraphQLURL
+	if opts.BaseURL != "" {
+		root := strings.TrimSuffix(strings.TrimSuffix(opts.BaseURL, "/"), "/api/v3")
+		enterprise, err := client.WithEnterpriseURLs(root, root)
+		if err != nil {
+			return nil, fmt.Errorf("invalid GitHub Enterprise URL %q: %w", opts.BaseURL, err)
+		}
+		client = enterprise
+		graphqlURL = graphQLEndpoint(client.BaseURL)
+	}
+
+	// credentials are attached beneath the rate budget, once the API base URL is known
+	switch {
+	case opts.App != nil:
+		src, err := newAppTokenSource(*opts.App, client.BaseURL.String())
+		if err != nil {
+			return nil, err
+		}
+		transport.base = &oauth2.Transport{Source: src, Base: http.DefaultTransport}
+	case opts.Token != "":
+		transport.base = &oauth2.Transport{
+			Source: oauth2.StaticTokenSource(&oauth2.Token{AccessToken: opts.Token}),
+			Base:   http.DefaultTransport,
+		}
+	}
+
+	concurrency := opts.Concurrency
+	if concurrency <= 0 {
+		concurrency = defaultConcurrency
+	}
+
 	return &Client{
 		client:                client,
-		orgs:                  orgs,
-		repos:                 repos,
-		username:              username,
-		includeReviewedPRs:    includeReviewedPRs,
-		includeAssignedIssues: includeAssignedIssues,
+		httpClient:            httpClient,
+		graphqlURL:            graphqlURL,
+		api:                   strings.ToLower(opts.API),
+		orgs:                  opts.Orgs,
+		repos:                 opts.Repos,
+		username:              opts.Username,
+		includeReviewedPRs:    opts.IncludeReviewedPRs,
+		includeAssignedIssues: opts.IncludeAssignedIssues,
+		includeCommits:        opts.IncludeCommits,
+		includeComments:       opts.IncludeComments,
+		includeReleases:       opts.IncludeReleases,
+		includeTags:           opts.IncludeTags,
+		branches:              opts.Branches,
+		dateField:             opts.DateField,
+		concurrency:           concurrency,
 		repoCache:             make(map[string][]*github.Repository),
+	}, nil
+}
+
+// graphQLEndpoint derives the GraphQL URL from a REST base URL: Enterprise Server
+// serves REST under /api/v3/ and GraphQL under /api/graphql.
+func graphQLEndpoint(base *url.URL) string {
+	u := *base
+	if strings.HasSuffix(u.Path, "/api/v3/") {
+		u.Path = strings.TrimSuffix(u.Path, "/api/v3/") + "/api/graphql"
+	} else {
+		u.Path = strings.TrimSuffix(u.Path, "/") + "/graphql"
+	}
+	return u.String()
+}
+
+// matchesBranch reports whether a base branch is selected by the configured branch filters.
+// With no filters every branch matches.
+func (c *Client) matchesBranch(ref string) bool {
+	if len(c.branches) == 0 {
+		return true
+	}
+	for _, pattern := range c.branches {
+		if ok, err := path.Match(pattern, ref); err == nil && ok {
+			return true
+		}
 	}
+	return false
 }
 
+// maxRateLimitRetries bounds how often a request is retried after being rate limited.
+const maxRateLimitRetries = 5
+
+// handleRateLimit waits out a rate limit and returns nil so the caller can retry.
+// Any other error, including a 403 without rate-limit headers (SSO enforcement,
+// missing repository access), is returned as is.
 func (c *Client) handleRateLimit(resp *github.Response, err error) error {
 	if resp == nil {
 		return err
 	}
 
+	if resp.StatusCode == 403 && resp.Header.Get("Retry-After") == "" && resp.Header.Get("X-RateLimit-Remaining") != "0" {
+		return err
+	}
+
 	if resp.StatusCode == 403 || resp.StatusCode == 429 {
 		retryAfter := resp.Header.Get("Retry-After")
 		if retryAfter != "" {
@@ -94,58 +219,196 @@ type PRWithCommits struct {
 
 // FetchPRsWithCommits fetches PRs authored by the user and attaches their commits.
 // This is the primary fetch — commits are derived from PRs, not searched separately.
+// PRs are found through the Search API unless specific repositories are configured.
 func (c *Client) FetchPRsWithCommits(ctx context.Context, start, end time.Time) ([]*PRWithCommits, error) {
-	var results []*PRWithCommits
-	seen := make(map[string]bool)
+	if c.api == APIGraphQL && c.byCreation() {
+		return c.fetchPRsWithCommitsGraphQL(ctx, start, end)
+	}
 
-	for _, org := range c.orgs {
-		repos, err := c.getOrgRepos(ctx, org)
-		if err != nil {
-			fmt.Printf("Warning: could not list repos for org %s: %v\n", org, err)
-			continue
+	var prs []*github.PullRequest
+	var err error
+	if len(c.repos) > 0 {
+		prs, err = c.listRepoPRs(ctx, start, end)
+	} else {
+		prs, err = c.searchPRs(ctx, start, end)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*PRWithCommits, len(prs))
+	c.forEach(len(prs), func(i int) {
+		pr := prs[i]
+		owner, repo := prRepository(pr)
+
+		// list results omit diff stats, which only the single-PR endpoint returns
+		if pr.Additions == nil {
+			full, err := c.getPR(ctx, owner, repo, pr.GetNumber())
+			if err != nil {
+				fmt.Printf("Warning: could not fetch diff stats for PR %s/%s#%d: %v\n", owner, repo, pr.GetNumber(), err)
+			} else {
+				pr = full
+			}
 		}
 
-		for _, repo := range repos {
-			prs, err := c.fetchPRsInRepo(ctx, org, *repo.Name, start, end)
+		// with branch filters, only commits of PRs whose merge is still on a
+		// selected branch count
+		var commits []*github.RepositoryCommit
+		if len(c.branches) == 0 || (pr.MergedAt != nil && c.onBranch(ctx, owner, repo, pr.GetBase().GetRef(), pr.GetMergeCommitSHA())) {
+			var err error
+			commits, err = c.fetchPRCommits(ctx, owner, repo, pr.GetNumber())
 			if err != nil {
-				fmt.Printf("Warning: could not fetch PRs for %s/%s: %v\n", org, *repo.Name, err)
-				continue
+				fmt.Printf("Warning: could not fetch commits for PR %s/%s#%d: %v\n", owner, repo, pr.GetNumber(), err)
+				commits = nil
 			}
+		}
 
-			for _, pr := range prs {
-				key := fmt.Sprintf("%s/%s#%d", org, *repo.Name, *pr.Number)
-				if seen[key] {
-					continue
-				}
-				seen[key] = true
+		results[i] = &PRWithCommits{
+			PR:      pr,
+			Commits: commits,
+		}
+	})
 
-				commits, err := c.fetchPRCommits(ctx, org, *repo.Name, *pr.Number)
-				if err != nil {
-					fmt.Printf("Warning: could not fetch commits for PR %s: %v\n", key, err)
-					commits = nil
-				}
+	return results, nil
+}
+
+// searchPRs finds the user's PRs with the Search API and loads each one in full,
+// since search results lack the base branch and merge state.
+func (c *Client) searchPRs(ctx context.Context, start, end time.Time) ([]*github.PullRequest, error) {
+	field, searchEnd := c.searchRange(end)
+	issues, err := c.searchIssues(ctx, fmt.Sprintf("type:pr author:%s", c.username), field, start, searchEnd)
+	if err != nil {
+		return nil, err
+	}
+
+	found := make([]*github.PullRequest, len(issues))
+	c.forEach(len(issues), func(i int) {
+		issue := issues[i]
+		if issue.Number == nil || issue.RepositoryURL == nil {
+			return
+		}
+		owner, repo := parseRepositoryURL(*issue.RepositoryURL)
 
-				results = append(results, &PRWithCommits{
-					PR:      pr,
-					Commits: commits,
-				})
+		pr, err := c.getPR(ctx, owner, repo, *issue.Number)
+		if err != nil {
+			fmt.Printf("Warning: could not fetch PR %s/%s#%d: %v\n", owner, repo, *issue.Number, err)
+			return
+		}
+		if c.matchesBranch(pr.GetBase().GetRef()) && c.inPeriod(pr.CreatedAt, pr.UpdatedAt, pr.ClosedAt, start, end) {
+			found[i] = pr
+		}
+	})
+
+	var prs []*github.PullRequest
+	for _, pr := range found {
+		if pr != nil {
+			prs = append(prs, pr)
+		}
+	}
+
+	return prs, nil
+}
+
+func (c *Client) getPR(ctx context.Context, owner, repo string, number int) (*github.PullRequest, error) {
+	for attempt := 0; ; attempt++ {
+		pr, resp, err := c.client.PullRequests.Get(ctx, owner, repo, number)
+		if err == nil {
+			return pr, nil
+		}
+		if attempt == maxRateLimitRetries {
+			return nil, err
+		}
+		if rateErr := c.handleRateLimit(resp, err); rateErr != nil {
+			return nil, rateErr
+		}
+	}
+}
+
+// onBranch reports whether a commit is reachable from a branch, i.e. the branch
+// is identical to or ahead of it. A merge without a known commit, or one that
+// cannot be compared, is trusted.
+func (c *Client) onBranch(ctx context.Context, owner, repo, branch, sha string) bool {
+	if sha == "" {
+		return true
+	}
+	opts := &github.ListOptions{PerPage: 1}
+	for attempt := 0; ; attempt++ {
+		comparison, resp, err := c.client.Repositories.CompareCommits(ctx, owner, repo, branch, sha, opts)
+		if err == nil {
+			status := comparison.GetStatus()
+			return status == "behind" || status == "identical"
+		}
+		if attempt < maxRateLimitRetries && c.handleRateLimit(resp, err) == nil {
+			continue
+		}
+		fmt.Printf("Warning: could not check %s/%s@%s against %s: %v\n", owner, repo, sha, branch, err)
+		return true
+	}
+}
+
+// listRepoPRs walks the PR lists of the configured repositories in parallel.
+func (c *Client) listRepoPRs(ctx context.Context, start, end time.Time) ([]*github.PullRequest, error) {
+	repos, err := c.coveredRepos(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	perRepo := make([][]*github.PullRequest, len(repos))
+	c.forEach(len(repos), func(i int) {
+		owner, name := repos[i].GetOwner().GetLogin(), repos[i].GetName()
+		prs, err := c.fetchPRsInRepo(ctx, owner, name, start, end)
+		if err != nil {
+			fmt.Printf("Warning: could not fetch PRs for %s/%s: %v\n", owner, name, err)
+			return
+		}
+		perRepo[i] = prs
+	})
+
+	var results []*github.PullRequest
+	seen := make(map[string]bool)
+	for _, prs := range perRepo {
+		for _, pr := range prs {
+			if pr.HTMLURL == nil || seen[*pr.HTMLURL] {
+				continue
 			}
+			seen[*pr.HTMLURL] = true
+			results = append(results, pr)
 		}
 	}
 
 	return results, nil
 }
 
-func (c *Client) fetchPRsInRepo(ctx context.Context, org, repo string, start, end time.Time) ([]*github.PullRequest, error) {
+// coveredRepos returns the repositories of every configured org, in org order.
+func (c *Client) coveredRepos(ctx context.Context) ([]*github.Repository, error) {
+	var all []*github.Repository
+	for _, org := range c.orgs {
+		repos, err := c.getOrgRepos(ctx, org)
+		if err != nil {
+			fmt.Printf("Warning: could not list repos for org %s: %v\n", org, err)
+			continue
+		}
+		all = append(all, repos...)
+	}
+	return all, nil
+}
+
+func (c *Client) fetchPRsInRepo(ctx context.Context, owner, repo string, start, end time.Time) ([]*github.PullRequest, error) {
 	var prs []*github.PullRequest
 
 	opts := &github.PullRequestListOptions{
 		State:       "all",
 		ListOptions: github.ListOptions{PerPage: 100},
 	}
+	// PRs are listed newest first by the date that bounds the range; updates
+	// follow any creation or close, so they bound every other field
+	if !c.byCreation() {
+		opts.Sort = "updated"
+		opts.Direction = "desc"
+	}
 
 	for {
-		result, resp, err := c.client.PullRequests.List(ctx, org, repo, opts)
+		result, resp, err := c.client.PullRequests.List(ctx, owner, repo, opts)
 		if err != nil {
 			if rateErr := c.handleRateLimit(resp, err); rateErr != nil {
 				return nil, rateErr
@@ -154,21 +417,28 @@ func (c *Client) fetchPRsInRepo(ctx context.Context, org, repo string, start, en
 		}
 
 		for _, pr := range result {
-			if pr.CreatedAt == nil {
+			if pr.CreatedAt == nil || pr.UpdatedAt == nil {
 				continue
 			}
-			if pr.CreatedAt.After(end) {
-				continue
+			listedAt := pr.CreatedAt
+			if !c.byCreation() {
+				listedAt = pr.UpdatedAt
 			}
-			if pr.CreatedAt.Before(start) {
+			if listedAt.Before(start) {
 				return prs, nil
 			}
+			if !c.inPeriod(pr.CreatedAt, pr.UpdatedAt, pr.ClosedAt, start, end) {
+				continue
+			}
 			if pr.User == nil || pr.User.Login == nil {
 				continue
 			}
 			if !strings.EqualFold(*pr.User.Login, c.username) {
 				continue
 			}
+			if len(c.branches) > 0 && (pr.Base == nil || !c.matchesBranch(pr.Base.GetRef())) {
+				continue
+			}
 			prs = append(prs, pr)
 		}
 
@@ -176,18 +446,17 @@ func (c *Client) fetchPRsInRepo(ctx context.Context, org, repo string, start, en
 			break
 		}
 		opts.Page = resp.NextPage
-		time.Sleep(100 * time.Millisecond)
 	}
 
 	return prs, nil
 }
 
-func (c *Client) fetchPRCommits(ctx context.Context, org, repo string, prNumber int) ([]*github.RepositoryCommit, error) {
+func (c *Client) fetchPRCommits(ctx context.Context, owner, repo string, prNumber int) ([]*github.RepositoryCommit, error) {
 	var commits []*github.RepositoryCommit
 
 	opts := &github.ListOptions{PerPage: 100}
 	for {
-		result, resp, err := c.client.PullRequests.ListCommits(ctx, org, repo, prNumber, opts)
+		result, resp, err := c.client.PullRequests.ListCommits(ctx, owner, repo, prNumber, opts)
 		if err != nil {
 			if rateErr := c.handleRateLimit(resp, err); rateErr != nil {
 				return nil, rateErr
@@ -199,71 +468,42 @@ func (c *Client) fetchPRCommits(ctx context.Context, org, repo string, prNumber
 			break
 		}
 		opts.Page = resp.NextPage
-		time.Sleep(100 * time.Millisecond)
 	}
 
 	return commits, nil
 }
 
-// FetchIssues fetches issues created by the user in the date range.
+// FetchIssues fetches issues created by the user whose date field falls in the range.
 func (c *Client) FetchIssues(ctx context.Context, start, end time.Time) ([]*github.Issue, error) {
+	field, searchEnd := c.searchRange(end)
+
+	var created []*github.Issue
+	var err error
+	if c.api == APIGraphQL && c.byCreation() {
+		created, err = c.fetchIssuesGraphQL(ctx, start, end)
+	} else if len(c.repos) > 0 {
+		created, err = c.listRepoIssues(ctx, start, end)
+	} else {
+		created, err = c.searchIssues(ctx, fmt.Sprintf("type:issue author:%s", c.username), field, start, searchEnd)
+		created = c.issuesInPeriod(created, start, end)
+	}
+	if err != nil {
+		return nil, err
+	}
+
 	var allIssues []*github.Issue
 	issueMap := make(map[string]bool)
-
-	for _, org := range c.orgs {
-		repos, err := c.getOrgRepos(ctx, org)
-		if err != nil {
-			fmt.Printf("Warning: could not list repos for org %s: %v\n", org, err)
-			continue
-		}
-
-		for _, repo := range repos {
-			opts := &github.IssueListByRepoOptions{
-				State:       "all",
-				Creator:     c.username,
-				Since:       start,
-				ListOptions: github.ListOptions{PerPage: 100},
-			}
-
-			for {
-				issues, resp, err := c.client.Issues.ListByRepo(ctx, org, *repo.Name, opts)
-				if err != nil {
-					if rateErr := c.handleRateLimit(resp, err); rateErr != nil {
-						return nil, rateErr
-					}
-					break
-				}
-
-				for _, issue := range issues {
-					if issue.IsPullRequest() {
-						continue
-					}
-					if issue.CreatedAt == nil || issue.CreatedAt.Before(start) || issue.CreatedAt.After(end) {
-						continue
-					}
-					key := fmt.Sprintf("%s/%s#%d", org, *repo.Name, *issue.Number)
-					if issueMap[key] {
-						continue
-					}
-					issueMap[key] = true
-					allIssues = append(allIssues, issue)
-				}
-
-				if resp.NextPage == 0 {
-					break
-				}
-				opts.Page = resp.NextPage
-				time.Sleep(100 * time.Millisecond)
-			}
-		}
+	for _, issue := range created {
+		issueMap[issue.GetHTMLURL()] = true
+		allIssues = append(allIssues, issue)
 	}
 
 	if c.includeAssignedIssues {
-		assigned, err := c.fetchAssignedIssues(ctx, start, end)
+		assigned, err := c.searchIssues(ctx, fmt.Sprintf("type:issue assignee:%s", c.username), field, start, searchEnd)
 		if err != nil {
 			return nil, err
 		}
-		for _, issue := range assigned {
+		for _, issue := range c.issuesInPeriod(assigned, start, end) {
 			if issue.HTMLURL == nil {
 				continue
 			}
@@ -278,44 +518,258 @@ func (c *Client) FetchIssues(ctx context.Context, start, end time.Time) ([]*gith
 	return allIssues, nil
 }
 
-func (c *Client) fetchAssignedIssues(ctx context.Context, start, end time.Time) ([]*github.Issue, error) {
+// listRepoIssues walks the issue lists of the configured repositories in parallel.
+func (c *Client) listRepoIssues(ctx context.Context, start, end time.Time) ([]*github.Issue, error) {
+	repos, err := c.coveredRepos(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	perRepo := make([][]*github.Issue, len(repos))
+	errs := make([]error, len(repos))
+	c.forEach(len(repos), func(i int) {
+		perRepo[i], errs[i] = c.fetchIssuesInRepo(ctx, repos[i].GetOwner().GetLogin(), repos[i].GetName(), start, end)
+	})
+
 	var allIssues []*github.Issue
-	seen := make(map[string]bool)
+	issueMap := make(map[string]bool)
+	for i, issues := range perRepo {
+		if errs[i] != nil {
+			return nil, errs[i]
+		}
+		for _, issue := range issues {
+			if issue.HTMLURL == nil || issueMap[*issue.HTMLURL] {
+				continue
+			}
+			issueMap[*issue.HTMLURL] = true
+			allIssues = append(allIssues, issue)
+		}
+	}
 
-	for _, org := range c.orgs {
-		query := fmt.Sprintf("assignee:%s type:issue created:%s..%s org:%s",
-			c.username, start.Format("2006-01-02"), end.Format("2006-01-02"), org)
+	return allIssues, nil
+}
+
+func (c *Client) fetchIssuesInRepo(ctx context.Context, owner, repo string, start, end time.Time) ([]*github.Issue, error) {
+	var issues []*github.Issue
+
+	opts := &github.IssueListByRepoOptions{
+		State:       "all",
+		Creator:     c.username,
+		Since:       start,
+		ListOptions: github.ListOptions{PerPage: 100},
+	}
+
+	for {
+		result, resp, err := c.client.Issues.ListByRepo(ctx, owner, repo, opts)
+		if err != nil {
+			if rateErr := c.handleRateLimit(resp, err); rateErr != nil {
+				return nil, rateErr
+			}
+			break
+		}
+
+		for _, issue := range result {
+			if issue.IsPullRequest() {
+				continue
+			}
+			if issue.CreatedAt == nil || !c.inPeriod(issue.CreatedAt, issue.UpdatedAt, issue.ClosedAt, start, end) {
+				continue
+			}
+			issues = append(issues, issue)
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	return issues, nil
+}
+
+// byCreation reports whether PRs and issues are selected by creation date.
+func (c *Client) byCreation() bool {
+	return c.dateField == "" || c.dateField == report.DateCreated
+}
+
+// searchRange returns the search qualifier for the date field and the end of
+// the search window. Any activity leaves an item updated after it, so "any"
+// searches updates up to now and relies on inPeriod to drop later ones.
+func (c *Client) searchRange(end time.Time) (string, time.Time) {
+	switch c.dateField {
+	case report.DateUpdated:
+		return "updated", end
+	case report.DateClosed:
+		return "closed", end
+	case report.DateAny:
+		return "updated", time.Now()
+	}
+	return "created", end
+}
+
+// inPeriod reports whether the configured date field falls within the range.
+func (c *Client) inPeriod(created, updated, closed *github.Timestamp, start, end time.Time) bool {
+	var createdAt, updatedAt time.Time
+	if created != nil {
+		createdAt = created.Time
+	}
+	if updated != nil {
+		updatedAt = updated.Time
+	}
+	return report.InPeriod(c.dateField, createdAt, updatedAt, closed.GetTime(), start, end)
+}
+
+func (c *Client) issuesInPeriod(issues []*github.Issue, start, end time.Time) []*github.Issue {
+	var results []*github.Issue
+	for _, issue := range issues {
+		if c.inPeriod(issue.CreatedAt, issue.UpdatedAt, issue.ClosedAt, start, end) {
+			results = append(results, issue)
+		}
+	}
+	return results
+}
+
+type ReviewedPR struct {
+	PR      *github.Issue
+	Reviews []*github.PullRequestReview
+}
+
+// FetchReviewedPRs fetches PRs by other authors that the user reviewed in the date range.
+// Only reviews submitted by the user inside the range are attached.
+func (c *Client) FetchReviewedPRs(ctx context.Context, start, end time.Time) ([]*ReviewedPR, error) {
+	if !c.includeReviewedPRs {
+		return nil, nil
+	}
+	if c.api == APIGraphQL {
+		return c.fetchReviewedPRsGraphQL(ctx, start, end)
+	}
 
-		opts := &github.SearchOptions{
-			ListOptions: github.ListOptions{PerPage: 100},
+	// a PR reviewed in the range may be updated after it, so the search runs up to
+	// now and the reviews themselves are filtered on their submission date
+	query := fmt.Sprintf("type:pr reviewed-by:%s -author:%s", c.username, c.username)
+	issues, err := c.searchIssues(ctx, query, "updated", start, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	found := make([]*ReviewedPR, len(issues))
+	c.forEach(len(issues), func(i int) {
+		issue := issues[i]
+		if issue.HTMLURL == nil || issue.Number == nil || issue.RepositoryURL == nil {
+			return
 		}
 
-		for {
-			result, resp, err := c.client.Search.Issues(ctx, query, opts)
+		owner, repo := parseRepositoryURL(*issue.RepositoryURL)
+
+		// search results lack the base branch, so filtered PRs are loaded in full
+		if len(c.branches) > 0 {
+			pr, err := c.getPR(ctx, owner, repo, *issue.Number)
 			if err != nil {
-				if rateErr := c.handleRateLimit(resp, err); rateErr != nil {
-					return nil, rateErr
-				}
-				break
+				fmt.Printf("Warning: could not fetch PR %s: %v\n", *issue.HTMLURL, err)
+				return
 			}
+			if !c.matchesBranch(pr.GetBase().GetRef()) {
+				return
+			}
+		}
 
-			for _, issue := range result.Issues {
-				if issue.HTMLURL == nil || seen[*issue.HTMLURL] {
-					continue
-				}
-				seen[*issue.HTMLURL] = true
-				allIssues = append(allIssues, issue)
+		reviews, err := c.fetchUserReviews(ctx, owner, repo, *issue.Number, start, end)
+		if err != nil {
+			fmt.Printf("Warning: could not fetch reviews for %s: %v\n", *issue.HTMLURL, err)
+			return
+		}
+		if len(reviews) > 0 {
+			found[i] = &ReviewedPR{
+				PR:      issue,
+				Reviews: reviews,
+			}
+		}
+	})
+
+	var results []*ReviewedPR
+	for _, entry := range found {
+		if entry != nil {
+			results = append(results, entry)
+		}
+	}
+
+	return results, nil
+}
+
+func (c *Client) fetchUserReviews(ctx context.Context, owner, repo string, prNumber int, start, end time.Time) ([]*github.PullRequestReview, error) {
+	var reviews []*github.PullRequestReview
+
+	opts := &github.ListOptions{PerPage: 100}
+	for {
+		result, resp, err := c.client.PullRequests.ListReviews(ctx, owner, repo, prNumber, opts)
+		if err != nil {
+			if rateErr := c.handleRateLimit(resp, err); rateErr != nil {
+				return nil, rateErr
 			}
+			return nil, err
+		}
 
-			if resp.NextPage == 0 {
-				break
+		for _, review := range result {
+			if review.User == nil || review.User.Login == nil || review.SubmittedAt == nil || review.State == nil {
+				continue
+			}
+			if !strings.EqualFold(*review.User.Login, c.username) {
+				continue
+			}
+			if review.SubmittedAt.Before(start) || review.SubmittedAt.After(end) {
+				continue
+			}
+			switch *review.State {
+			case "APPROVED", "CHANGES_REQUESTED", "COMMENTED":
+				reviews = append(reviews, review)
 			}
-			opts.Page = resp.NextPage
-			time.Sleep(100 * time.Millisecond)
 		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
 	}
 
-	return allIssues, nil
+	return reviews, nil
+}
+
+// prRepository returns the owner and name of the repository a PR targets.
+func prRepository(pr *github.PullRequest) (string, string) {
+	repo := pr.GetBase().GetRepo()
+	return repo.GetOwner().GetLogin(), repo.GetName()
+}
+
+// coversRepo reports whether owner/repo belongs to a configured org and, when
+// specific repositories are configured, is one of them.
+func (c *Client) coversRepo(owner, repo string) bool {
+	inOrg := false
+	for _, org := range c.orgs {
+		if strings.EqualFold(org, owner) {
+			inOrg = true
+			break
+		}
+	}
+	if !inOrg || len(c.repos) == 0 {
+		return inOrg
+	}
+
+	for _, name := range c.repos {
+		name = strings.TrimSpace(name)
+		if strings.EqualFold(name, repo) || strings.EqualFold(name, owner+"/"+repo) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseRepositoryURL splits an API repository URL (.../repos/{owner}/{repo}) into owner and name.
+func parseRepositoryURL(repositoryURL string) (string, string) {
+	parts := strings.Split(strings.TrimSuffix(repositoryURL, "/"), "/")
+	if len(parts) < 2 {
+		return "", ""
+	}
+	return parts[len(parts)-2], parts[len(parts)-1]
 }
 
 func (c *Client) getOrgRepos(ctx context.Context, org string) ([]*github.Repository, error) {
@@ -364,7 +818,6 @@ func (c *Client) getOrgRepos(ctx context.Context, org string) ([]*github.Reposit
 			break
 		}
 		opts.Page = resp.NextPage
-		time.Sleep(100 * time.Millisecond)
 	}
 
 	c.repoCache[org] = repos
@@ -0,0 +1,201 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Afrawles/devreport/internal/report"
+)
+
+func TestNewClientEnterpriseURLs(t *testing.T) {
+	tests := []struct {
+		baseURL    string
+		wantREST   string
+		wantUpload string
+		wantGQL    string
+	}{
+		{"", "https://api.github.com/", "https://uploads.github.com/", "https://api.github.com/graphql"},
+		{"https://ghe.example.com", "https://ghe.example.com/api/v3/", "https://ghe.example.com/api/uploads/", "https://ghe.example.com/api/graphql"},
+		{"https://ghe.example.com/api/v3/", "https://ghe.example.com/api/v3/", "https://ghe.example.com/api/uploads/", "https://ghe.example.com/api/graphql"},
+		{"https://example.com/github", "https://example.com/github/api/v3/", "https://example.com/github/api/uploads/", "https://example.com/github/api/graphql"},
+	}
+
+	for _, tt := range tests {
+		c, err := NewClient(Options{BaseURL: tt.baseURL})
+		if err != nil {
+			t.Fatalf("NewClient(%q): %v", tt.baseURL, err)
+		}
+		if got := c.client.BaseURL.String(); got != tt.wantREST {
+			t.Errorf("NewClient(%q) REST URL = %q, want %q", tt.baseURL, got, tt.wantREST)
+		}
+		if got := c.client.UploadURL.String(); got != tt.wantUpload {
+			t.Errorf("NewClient(%q) upload URL = %q, want %q", tt.baseURL, got, tt.wantUpload)
+		}
+		if c.graphqlURL != tt.wantGQL {
+			t.Errorf("NewClient(%q) GraphQL URL = %q, want %q", tt.baseURL, c.graphqlURL, tt.wantGQL)
+		}
+	}
+}
+
+func TestExtractRepoName(t *testing.T) {
+	tests := map[string]string{
+		"https://github.com/acme/widgets/pull/7":               "widgets",
+		"https://github.com/acme/widgets/issues/12":            "widgets",
+		"https://ghe.example.com/acme/widgets/pull/7":          "widgets",
+		"https://example.com/github/acme/widgets/pull/7":       "widgets",
+		"https://example.com/github/acme/issues/issues/3":      "issues",
+		"https://ghe.example.com/acme/widgets/releases/tag/v1": "widgets",
+		"https://ghe.example.com/acme/widgets":                 "widgets",
+		"not-a-url":                                            "unknown",
+	}
+
+	for url, want := range tests {
+		if got := extractRepoName(url); got != want {
+			t.Errorf("extractRepoName(%q) = %q, want %q", url, got, want)
+		}
+	}
+}
+
+// newEnterpriseServer emulates the REST and GraphQL paths of a GitHub Enterprise Server.
+func newEnterpriseServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	writeJSON := func(w http.ResponseWriter, v any) {
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(v); err != nil {
+			t.Errorf("encode response: %v", err)
+		}
+	}
+
+	mux.HandleFunc("/api/v3/search/issues", func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query().Get("q")
+		if !strings.Contains(q, "author:gon") || !strings.Contains(q, "org:acme") {
+			t.Errorf("unexpected search query %q", q)
+		}
+		writeJSON(w, map[string]any{
+			"total_count": 1,
+			"items": []map[string]any{{
+				"number":         7,
+				"title":          "Add widget export",
+				"html_url":       server.URL + "/acme/widgets/pull/7",
+				"repository_url": server.URL + "/api/v3/repos/acme/widgets",
+				"pull_request":   map[string]any{"url": server.URL + "/api/v3/repos/acme/widgets/pulls/7"},
+			}},
+		})
+	})
+
+	mux.HandleFunc("/api/v3/repos/acme/widgets/pulls/7", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, map[string]any{
+			"number":        7,
+			"title":         "Add widget export",
+			"state":         "closed",
+			"html_url":      server.URL + "/acme/widgets/pull/7",
+			"created_at":    "2025-10-02T10:00:00Z",
+			"updated_at":    "2025-10-03T10:00:00Z",
+			"merged_at":     "2025-10-03T10:00:00Z",
+			"additions":     40,
+			"deletions":     5,
+			"changed_files": 3,
+			"commits":       1,
+			"user":          map[string]any{"login": "gon"},
+			"base": map[string]any{
+				"ref":  "main",
+				"repo": map[string]any{"name": "widgets", "owner": map[string]any{"login": "acme"}},
+			},
+		})
+	})
+
+	mux.HandleFunc("/api/v3/repos/acme/widgets/pulls/7/commits", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, []map[string]any{{
+			"sha":    "abc123",
+			"commit": map[string]any{"message": "Export widgets as CSV"},
+		}})
+	})
+
+	mux.HandleFunc("/api/graphql", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, map[string]any{
+			"data": map[string]any{
+				"user": map[string]any{
+					"contributionsCollection": map[string]any{
+						"pullRequestContributions": map[string]any{
+							"pageInfo": map[string]any{"hasNextPage": false},
+							"nodes": []map[string]any{{
+								"pullRequest": map[string]any{
+									"number":       7,
+									"title":        "Add widget export",
+									"url":          server.URL + "/acme/widgets/pull/7",
+									"state":        "MERGED",
+									"createdAt":    "2025-10-02T10:00:00Z",
+									"updatedAt":    "2025-10-03T10:00:00Z",
+									"mergedAt":     "2025-10-03T10:00:00Z",
+									"baseRefName":  "main",
+									"additions":    40,
+									"deletions":    5,
+									"changedFiles": 3,
+									"repository":   map[string]any{"name": "widgets", "owner": map[string]any{"login": "acme"}},
+									"commits": map[string]any{
+										"totalCount": 1,
+										"nodes":      []map[string]any{{"commit": map[string]any{"oid": "abc123", "message": "Export widgets as CSV"}}},
+									},
+								},
+							}},
+						},
+					},
+				},
+			},
+		})
+	})
+
+	return server
+}
+
+func TestFetchPRsWithCommitsEnterprise(t *testing.T) {
+	server := newEnterpriseServer(t)
+	start := time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2025, 10, 31, 0, 0, 0, 0, time.UTC)
+
+	for _, api := range []string{APIREST, APIGraphQL} {
+		c, err := NewClient(Options{
+			Token:    "test-token",
+			Orgs:     []string{"acme"},
+			Username: "gon",
+			API:      api,
+			BaseURL:  server.URL,
+		})
+		if err != nil {
+			t.Fatalf("NewClient: %v", err)
+		}
+
+		prs, err := c.FetchPRsWithCommits(context.Background(), start, end)
+		if err != nil {
+			t.Fatalf("%s: FetchPRsWithCommits: %v", api, err)
+		}
+		if len(prs) != 1 {
+			t.Fatalf("%s: got %d PRs, want 1", api, len(prs))
+		}
+
+		pr := prs[0]
+		if pr.PR.GetNumber() != 7 || pr.PR.GetBase().GetRef() != "main" {
+			t.Errorf("%s: unexpected PR %+v", api, pr.PR)
+		}
+		if len(pr.Commits) != 1 || pr.Commits[0].GetSHA() != "abc123" {
+			t.Errorf("%s: unexpected commits %+v", api, pr.Commits)
+		}
+		want := report.CodeMetrics{Additions: 40, Deletions: 5, FilesChanged: 3, Commits: 1}
+		if got := prMetrics(pr.PR, pr.Commits); got == nil || *got != want {
+			t.Errorf("%s: metrics = %+v, want %+v", api, got, want)
+		}
+		if got := extractRepoName(pr.PR.GetHTMLURL()); got != "widgets" {
+			t.Errorf("%s: repo name = %q, want widgets", api, got)
+		}
+	}
+}
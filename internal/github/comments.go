@@ -0,0 +1,138 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v60/github"
+)
+
+// Discussion holds the comments the user left on a single issue or PR.
+type Discussion struct {
+	Issue          *github.Issue
+	IssueComments  []*github.IssueComment
+	ReviewComments []*github.PullRequestComment
+}
+
+// CommentCount returns the number of comments the user left in the discussion.
+func (d *Discussion) CommentCount() int {
+	return len(d.IssueComments) + len(d.ReviewComments)
+}
+
+// FetchDiscussions finds issues and PRs the user commented on in the date range
+// and collects their comments, including PR review comments on the diff.
+func (c *Client) FetchDiscussions(ctx context.Context, start, end time.Time) ([]*Discussion, error) {
+	if !c.includeComments {
+		return nil, nil
+	}
+
+	// a discussion commented on in the range may be updated after it, so the search
+	// runs up to now and the comments themselves are filtered on their creation date
+	issues, err := c.searchIssues(ctx, fmt.Sprintf("commenter:%s", c.username), "updated", start, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	found := make([]*Discussion, len(issues))
+	c.forEach(len(issues), func(i int) {
+		issue := issues[i]
+		if issue.HTMLURL == nil || issue.Number == nil || issue.RepositoryURL == nil {
+			return
+		}
+		owner, repo := parseRepositoryURL(*issue.RepositoryURL)
+
+		issueComments, err := c.fetchUserIssueComments(ctx, owner, repo, *issue.Number, start, end)
+		if err != nil {
+			fmt.Printf("Warning: could not fetch comments for %s: %v\n", *issue.HTMLURL, err)
+			return
+		}
+		discussion := &Discussion{Issue: issue, IssueComments: issueComments}
+		if issue.IsPullRequest() {
+			discussion.ReviewComments, err = c.fetchUserReviewComments(ctx, owner, repo, *issue.Number, start, end)
+			if err != nil {
+				fmt.Printf("Warning: could not fetch review comments for %s: %v\n", *issue.HTMLURL, err)
+				return
+			}
+		}
+		if discussion.CommentCount() > 0 {
+			found[i] = discussion
+		}
+	})
+
+	var results []*Discussion
+	for _, discussion := range found {
+		if discussion != nil {
+			results = append(results, discussion)
+		}
+	}
+
+	return results, nil
+}
+
+func (c *Client) fetchUserIssueComments(ctx context.Context, owner, repo string, number int, start, end time.Time) ([]*github.IssueComment, error) {
+	var comments []*github.IssueComment
+
+	opts := &github.IssueListCommentsOptions{
+		Since:       &start,
+		ListOptions: github.ListOptions{PerPage: 100},
+	}
+	for {
+		result, resp, err := c.client.Issues.ListComments(ctx, owner, repo, number, opts)
+		if err != nil {
+			if rateErr := c.handleRateLimit(resp, err); rateErr != nil {
+				return nil, rateErr
+			}
+			return nil, err
+		}
+		for _, comment := range result {
+			if c.isUserComment(comment.GetUser(), comment.CreatedAt, start, end) {
+				comments = append(comments, comment)
+			}
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	return comments, nil
+}
+
+func (c *Client) fetchUserReviewComments(ctx context.Context, owner, repo string, number int, start, end time.Time) ([]*github.PullRequestComment, error) {
+	var comments []*github.PullRequestComment
+
+	opts := &github.PullRequestListCommentsOptions{
+		Since:       start,
+		ListOptions: github.ListOptions{PerPage: 100},
+	}
+	for {
+		result, resp, err := c.client.PullRequests.ListComments(ctx, owner, repo, number, opts)
+		if err != nil {
+			if rateErr := c.handleRateLimit(resp, err); rateErr != nil {
+				return nil, rateErr
+			}
+			return nil, err
+		}
+		for _, comment := range result {
+			if c.isUserComment(comment.GetUser(), comment.CreatedAt, start, end) {
+				comments = append(comments, comment)
+			}
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	return comments, nil
+}
+
+// isUserComment reports whether a comment was written by the user within the range.
+func (c *Client) isUserComment(user *github.User, createdAt *github.Timestamp, start, end time.Time) bool {
+	if createdAt == nil || !strings.EqualFold(user.GetLogin(), c.username) {
+		return false
+	}
+	return !createdAt.Before(start) && !createdAt.After(end)
+}
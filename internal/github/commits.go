@@ -0,0 +1,323 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/go-github/v60/github"
+)
+
+type commitRef struct {
+	owner, repo, sha string
+}
+
+// FetchDirectCommits fetches commits the user authored in the date range on the
+// default branch of every covered repository, or on the branches matching the
+// configured branch filters. Non-default branches are only read in the
+// repositories given with --github-repos. Commits are loaded in full so their stats are known.
+// Commits in credited, or belonging to any pull request, are left out: they were
+// pushed through a PR, even one opened before the range.
+func (c *Client) FetchDirectCommits(ctx context.Context, start, end time.Time, credited map[string]bool) ([]*github.RepositoryCommit, error) {
+	if !c.includeCommits {
+		return nil, nil
+	}
+
+	var refs []commitRef
+	var err error
+	switch {
+	case len(c.repos) > 0:
+		refs, err = c.listRepoCommits(ctx, start, end)
+	case len(c.branches) > 0:
+		// walking the branches of every org repository costs too many requests, so
+		// only the default branches the search indexes are read, when they match
+		fmt.Println("Note: without --github-repos, direct commits are only found on default branches matching --github-branches")
+		refs, err = c.searchCommits(ctx, start, end)
+		if err == nil {
+			refs, err = c.onMatchingDefaultBranch(ctx, refs)
+		}
+	default:
+		refs, err = c.searchCommits(ctx, start, end)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	found := make([]*github.RepositoryCommit, len(refs))
+	c.forEach(len(refs), func(i int) {
+		ref := refs[i]
+		if credited[ref.sha] {
+			return
+		}
+		inPR, err := c.commitInPR(ctx, ref.owner, ref.repo, ref.sha)
+		if err != nil {
+			fmt.Printf("Warning: could not look up PRs for commit %s/%s@%s: %v\n", ref.owner, ref.repo, ref.sha, err)
+		}
+		if inPR {
+			return
+		}
+		commit, err := c.getCommit(ctx, ref.owner, ref.repo, ref.sha)
+		if err != nil {
+			fmt.Printf("Warning: could not fetch commit %s/%s@%s: %v\n", ref.owner, ref.repo, ref.sha, err)
+			return
+		}
+		found[i] = commit
+	})
+
+	var commits []*github.RepositoryCommit
+	for _, commit := range found {
+		if commit != nil {
+			commits = append(commits, commit)
+		}
+	}
+
+	return commits, nil
+}
+
+// searchCommits finds the user's commits with the commit Search API, which only
+// indexes default branches.
+func (c *Client) searchCommits(ctx context.Context, start, end time.Time) ([]commitRef, error) {
+	var refs []commitRef
+	seen := make(map[string]bool)
+
+	for _, scope := range c.searchScopes() {
+		if err := c.searchCommitWindow(ctx, scope, start, end, seen, &refs); err != nil {
+			return nil, err
+		}
+	}
+
+	return refs, nil
+}
+
+// searchCommitWindow collects the commits of one scope, splitting windows that
+// hold more results than the API will return, like searchWindow.
+func (c *Client) searchCommitWindow(ctx context.Context, scope string, start, end time.Time, seen map[string]bool, refs *[]commitRef) error {
+	query := fmt.Sprintf("author:%s %s author-date:%s..%s", c.username, scope, formatSearchTime(start), formatSearchTime(end))
+	opts := &github.SearchOptions{
+		Sort:        "author-date",
+		Order:       "asc",
+		ListOptions: github.ListOptions{PerPage: 100},
+	}
+
+	retries := 0
+	for {
+		result, resp, err := c.client.Search.Commits(ctx, query, opts)
+		if err != nil {
+			if retries == maxRateLimitRetries {
+				return err
+			}
+			if rateErr := c.handleRateLimit(resp, err); rateErr != nil {
+				return rateErr
+			}
+			retries++
+			continue
+		}
+		retries = 0
+
+		if opts.Page == 0 && result.GetTotal() > searchResultCap {
+			if end.Sub(start) > time.Minute {
+				mid := start.Add(end.Sub(start) / 2).Truncate(time.Second)
+				fmt.Printf("  %d commits for %q, splitting date range\n", result.GetTotal(), scope)
+				if err := c.searchCommitWindow(ctx, scope, start, mid, seen, refs); err != nil {
+					return err
+				}
+				return c.searchCommitWindow(ctx, scope, mid.Add(time.Second), end, seen, refs)
+			}
+			fmt.Printf("Warning: %d commits match %q within a minute, only the first %d are reported\n", result.GetTotal(), scope, searchResultCap)
+		}
+
+		for _, commit := range result.Commits {
+			sha := commit.GetSHA()
+			if sha == "" || seen[sha] || commit.Repository == nil {
+				continue
+			}
+			seen[sha] = true
+			*refs = append(*refs, commitRef{
+				owner: commit.Repository.GetOwner().GetLogin(),
+				repo:  commit.Repository.GetName(),
+				sha:   sha,
+			})
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	return nil
+}
+
+// onMatchingDefaultBranch keeps the commits whose repository's default branch
+// matches the branch filters.
+func (c *Client) onMatchingDefaultBranch(ctx context.Context, refs []commitRef) ([]commitRef, error) {
+	matches := make(map[string]bool)
+	var kept []commitRef
+	for _, ref := range refs {
+		key := ref.owner + "/" + ref.repo
+		match, ok := matches[key]
+		if !ok {
+			repo, err := c.getRepo(ctx, ref.owner, ref.repo)
+			if err != nil {
+				return nil, err
+			}
+			match = c.matchesBranch(repo.GetDefaultBranch())
+			matches[key] = match
+		}
+		if match {
+			kept = append(kept, ref)
+		}
+	}
+	return kept, nil
+}
+
+func (c *Client) getRepo(ctx context.Context, owner, name string) (*github.Repository, error) {
+	for attempt := 0; ; attempt++ {
+		repo, resp, err := c.client.Repositories.Get(ctx, owner, name)
+		if err == nil {
+			return repo, nil
+		}
+		if attempt == maxRateLimitRetries {
+			return nil, err
+		}
+		if rateErr := c.handleRateLimit(resp, err); rateErr != nil {
+			return nil, rateErr
+		}
+	}
+}
+
+// listRepoCommits lists the user's commits on the selected branches of every
+// configured repository in parallel.
+func (c *Client) listRepoCommits(ctx context.Context, start, end time.Time) ([]commitRef, error) {
+	repos, err := c.coveredRepos(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	perRepo := make([][]commitRef, len(repos))
+	c.forEach(len(repos), func(i int) {
+		owner, name := repos[i].GetOwner().GetLogin(), repos[i].GetName()
+		branches, err := c.commitBranches(ctx, repos[i])
+		if err != nil {
+			fmt.Printf("Warning: could not list branches for %s/%s: %v\n", owner, name, err)
+			return
+		}
+		for _, branch := range branches {
+			refs, err := c.fetchCommitsOnBranch(ctx, owner, name, branch, start, end)
+			if err != nil {
+				fmt.Printf("Warning: could not fetch commits for %s/%s@%s: %v\n", owner, name, branch, err)
+				continue
+			}
+			perRepo[i] = append(perRepo[i], refs...)
+		}
+	})
+
+	var refs []commitRef
+	seen := make(map[string]bool)
+	for _, repoRefs := range perRepo {
+		for _, ref := range repoRefs {
+			if seen[ref.sha] {
+				continue
+			}
+			seen[ref.sha] = true
+			refs = append(refs, ref)
+		}
+	}
+
+	return refs, nil
+}
+
+// commitBranches returns the repository's default branch, or every branch
+// matching the branch filters when any are configured.
+func (c *Client) commitBranches(ctx context.Context, repo *github.Repository) ([]string, error) {
+	if len(c.branches) == 0 {
+		return []string{repo.GetDefaultBranch()}, nil
+	}
+
+	var branches []string
+	opts := &github.BranchListOptions{
+		ListOptions: github.ListOptions{PerPage: 100},
+	}
+	for {
+		result, resp, err := c.client.Repositories.ListBranches(ctx, repo.GetOwner().GetLogin(), repo.GetName(), opts)
+		if err != nil {
+			if rateErr := c.handleRateLimit(resp, err); rateErr != nil {
+				return nil, rateErr
+			}
+			return nil, err
+		}
+		for _, branch := range result {
+			if c.matchesBranch(branch.GetName()) {
+				branches = append(branches, branch.GetName())
+			}
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	return branches, nil
+}
+
+func (c *Client) fetchCommitsOnBranch(ctx context.Context, owner, repo, branch string, start, end time.Time) ([]commitRef, error) {
+	var refs []commitRef
+
+	opts := &github.CommitsListOptions{
+		SHA:         branch,
+		Author:      c.username,
+		Since:       start,
+		Until:       end,
+		ListOptions: github.ListOptions{PerPage: 100},
+	}
+	for {
+		result, resp, err := c.client.Repositories.ListCommits(ctx, owner, repo, opts)
+		if err != nil {
+			if rateErr := c.handleRateLimit(resp, err); rateErr != nil {
+				return nil, rateErr
+			}
+			return nil, err
+		}
+		for _, commit := range result {
+			refs = append(refs, commitRef{owner: owner, repo: repo, sha: commit.GetSHA()})
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	return refs, nil
+}
+
+// commitInPR reports whether a commit belongs to a pull request, including the
+// squash or merge commit a PR landed as.
+func (c *Client) commitInPR(ctx context.Context, owner, repo, sha string) (bool, error) {
+	for attempt := 0; ; attempt++ {
+		prs, resp, err := c.client.PullRequests.ListPullRequestsWithCommit(ctx, owner, repo, sha, &github.ListOptions{PerPage: 1})
+		if err == nil {
+			return len(prs) > 0, nil
+		}
+		if attempt == maxRateLimitRetries {
+			return false, err
+		}
+		if rateErr := c.handleRateLimit(resp, err); rateErr != nil {
+			return false, rateErr
+		}
+	}
+}
+
+func (c *Client) getCommit(ctx context.Context, owner, repo, sha string) (*github.RepositoryCommit, error) {
+	for attempt := 0; ; attempt++ {
+		commit, resp, err := c.client.Repositories.GetCommit(ctx, owner, repo, sha, nil)
+		if err == nil {
+			return commit, nil
+		}
+		if attempt == maxRateLimitRetries {
+			return nil, err
+		}
+		if rateErr := c.handleRateLimit(resp, err); rateErr != nil {
+			return nil, rateErr
+		}
+	}
+}
@@ -3,21 +3,28 @@ package github
 import (
 	"context"
 	"fmt"
+	"net/url"
+	"sort"
 	"strings"
 	"time"
 
+	"github.com/Afrawles/devreport/internal/llm"
 	"github.com/Afrawles/devreport/internal/report"
 	gogithub "github.com/google/go-github/v60/github"
 )
 
 type GitHubSource struct {
 	Client *Client
+	// Rephraser rewrites PRs, commits and releases as achievements; nil keeps them as written.
+	Rephraser llm.Rephraser
 }
 
-func NewGitHubSource(token string, orgs []string, username string, repos []string, includeReviewedPRs, includeAssignedIssues bool) *GitHubSource {
-	return &GitHubSource{
-		Client: NewClient(token, orgs, repos, username, includeReviewedPRs, includeAssignedIssues),
+func NewGitHubSource(opts Options) (*GitHubSource, error) {
+	client, err := NewClient(opts)
+	if err != nil {
+		return nil, err
 	}
+	return &GitHubSource{Client: client}, nil
 }
 
 var _ report.ActivitySource = (*GitHubSource)(nil)
@@ -34,20 +41,31 @@ func (g *GitHubSource) FetchTasks(user string, start, end time.Time) ([]report.T
 	ctx := context.Background()
 	var allTasks []report.Task
 
+	// SHAs of commits already credited through a PR, including squash/merge commits;
+	// direct commits outside this set are still checked against their PRs
+	prSHAs := make(map[string]bool)
+
 	prsWithCommits, err := g.Client.FetchPRsWithCommits(ctx, start, end)
 	if err != nil {
 		fmt.Printf("Error fetching PRs: %v\n", err)
 	} else {
 		for _, entry := range prsWithCommits {
 			pr := entry.PR
+			for _, commit := range entry.Commits {
+				prSHAs[commit.GetSHA()] = true
+			}
+			if sha := pr.GetMergeCommitSHA(); sha != "" {
+				prSHAs[sha] = true
+			}
 			if pr.CreatedAt == nil || pr.HTMLURL == nil || pr.Number == nil || pr.Title == nil || pr.State == nil || pr.UpdatedAt == nil {
 				continue
 			}
 
 			repoName := extractRepoName(*pr.HTMLURL)
+			// only a merge completes a PR; closed-unmerged PRs were abandoned
 			var completedAt *time.Time
-			if pr.ClosedAt != nil {
-				t := pr.ClosedAt.Time
+			if pr.MergedAt != nil {
+				t := pr.MergedAt.Time
 				completedAt = &t
 			}
 
@@ -58,14 +76,14 @@ func (g *GitHubSource) FetchTasks(user string, start, end time.Time) ([]report.T
 			}
 
 			achievementInput := buildAchievementInput(title, body, entry.Commits)
-			achievement := rephrasePR(title, achievementInput)
+			achievement := g.rephrasePR(title, achievementInput)
 
 			task := report.Task{
 				ID:           fmt.Sprintf("%d", *pr.Number),
 				Title:        title,
 				Description:  body,
 				Achievements: achievement,
-				Status:       *pr.State,
+				Status:       prStatus(pr),
 				URL:          *pr.HTMLURL,
 				CreatedAt:    pr.CreatedAt.Time,
 				UpdatedAt:    pr.UpdatedAt.Time,
@@ -73,7 +91,9 @@ func (g *GitHubSource) FetchTasks(user string, start, end time.Time) ([]report.T
 				Source:       repoName,
 				Type:         "Pull Request",
 				Assignee:     g.Client.username,
+				Metrics:      prMetrics(pr, entry.Commits),
 			}
+			task.StatusCategory = prCategory(pr)
 			allTasks = append(allTasks, task)
 		}
 	}
@@ -100,7 +120,7 @@ func (g *GitHubSource) FetchTasks(user string, start, end time.Time) ([]report.T
 				body = cleanActivityText(*issue.Body)
 			}
 
-			achievement := rephraseCommit(title)
+			achievement := g.rephraseCommit(title)
 
 			task := report.Task{
 				ID:           fmt.Sprintf("%d", *issue.Number),
@@ -116,13 +136,388 @@ func (g *GitHubSource) FetchTasks(user string, start, end time.Time) ([]report.T
 				Type:         "Issue",
 				Assignee:     g.Client.username,
 			}
+			task.StatusCategory = issueCategory(issue)
+			allTasks = append(allTasks, task)
+		}
+	}
+
+	reviewed, err := g.Client.FetchReviewedPRs(ctx, start, end)
+	if err != nil {
+		fmt.Printf("Error fetching reviewed PRs: %v\n", err)
+	} else {
+		for _, entry := range reviewed {
+			pr := entry.PR
+			if pr.HTMLURL == nil || pr.Number == nil || pr.Title == nil {
+				continue
+			}
+
+			first, last := reviewWindow(entry.Reviews)
+			verdict := reviewVerdict(entry.Reviews)
+			title := *pr.Title
+
+			task := report.Task{
+				ID:            fmt.Sprintf("%d", *pr.Number),
+				Title:         title,
+				Achievements:  buildReviewAchievement(*pr.Number, title, verdict, len(entry.Reviews)),
+				Status:        verdict,
+				URL:           *pr.HTMLURL,
+				CreatedAt:     first,
+				UpdatedAt:     last,
+				CompletedAt:   &last,
+				Source:        extractRepoName(*pr.HTMLURL),
+				Type:          "Review",
+				Assignee:      g.Client.username,
+				ReviewCount:   len(entry.Reviews),
+				ReviewVerdict: verdict,
+			}
+			allTasks = append(allTasks, task)
+		}
+	}
+
+	discussions, err := g.Client.FetchDiscussions(ctx, start, end)
+	if err != nil {
+		fmt.Printf("Error fetching discussions: %v\n", err)
+	} else {
+		for _, discussion := range discussions {
+			issue := discussion.Issue
+			first, last, body := discussionWindow(discussion)
+			kind := "issue"
+			if issue.IsPullRequest() {
+				kind = "pull request"
+			}
+
+			task := report.Task{
+				ID:           fmt.Sprintf("%d", issue.GetNumber()),
+				Title:        issue.GetTitle(),
+				Description:  body,
+				Achievements: buildDiscussionAchievement(kind, issue.GetNumber(), issue.GetTitle(), discussion.CommentCount()),
+				Status:       "commented",
+				URL:          issue.GetHTMLURL(),
+				CreatedAt:    first,
+				UpdatedAt:    last,
+				CompletedAt:  &last,
+				Source:       extractRepoName(issue.GetHTMLURL()),
+				Type:         "Discussion",
+				Assignee:     g.Client.username,
+				CommentCount: discussion.CommentCount(),
+			}
+			allTasks = append(allTasks, task)
+		}
+	}
+
+	// tags that already have a release are reported through the release
+	releasedTags := make(map[string]bool)
+
+	releases, err := g.Client.FetchReleases(ctx, start, end)
+	if err != nil {
+		fmt.Printf("Error fetching releases: %v\n", err)
+	} else {
+		for _, release := range releases {
+			if release.HTMLURL == nil || release.PublishedAt == nil {
+				continue
+			}
+
+			repoName := extractRepoName(*release.HTMLURL)
+			releasedTags[repoName+"@"+release.GetTagName()] = true
+
+			name := release.GetName()
+			if strings.TrimSpace(name) == "" {
+				name = release.GetTagName()
+			}
+			notes := cleanActivityText(release.GetBody())
+
+			status := "released"
+			if release.GetPrerelease() {
+				status = "pre-release"
+			}
+
+			createdAt := release.PublishedAt.Time
+			if release.CreatedAt != nil {
+				createdAt = release.CreatedAt.Time
+			}
+			publishedAt := release.PublishedAt.Time
+
+			task := report.Task{
+				ID:           release.GetTagName(),
+				Title:        fmt.Sprintf("Release %s", name),
+				Description:  notes,
+				Achievements: g.rephraseRelease(name, notes),
+				Status:       status,
+				URL:          *release.HTMLURL,
+				CreatedAt:    createdAt,
+				UpdatedAt:    publishedAt,
+				CompletedAt:  &publishedAt,
+				Source:       repoName,
+				Type:         "Release",
+				Assignee:     g.Client.username,
+			}
+			allTasks = append(allTasks, task)
+		}
+	}
+
+	tags, err := g.Client.FetchTags(ctx, start, end)
+	if err != nil {
+		fmt.Printf("Error fetching tags: %v\n", err)
+	} else {
+		for _, tag := range tags {
+			if releasedTags[tag.Repo+"@"+tag.Tag] {
+				continue
+			}
+			createdAt := tag.CreatedAt
+
+			task := report.Task{
+				ID:           tag.Tag,
+				Title:        fmt.Sprintf("Tagged %s", tag.Tag),
+				Achievements: fmt.Sprintf("Tagged %s in %s", tag.Tag, tag.Repo),
+				Status:       "tagged",
+				URL:          tag.URL,
+				CreatedAt:    createdAt,
+				UpdatedAt:    createdAt,
+				CompletedAt:  &createdAt,
+				Source:       tag.Repo,
+				Type:         "Release",
+				Assignee:     g.Client.username,
+			}
 			allTasks = append(allTasks, task)
 		}
 	}
 
+	commits, err := g.Client.FetchDirectCommits(ctx, start, end, prSHAs)
+	if err != nil {
+		fmt.Printf("Error fetching commits: %v\n", err)
+	} else {
+		allTasks = append(allTasks, g.buildCommitTasks(commits)...)
+	}
+
 	return allTasks, nil
 }
 
+// buildCommitTasks groups direct commits into one task per repository.
+func (g *GitHubSource) buildCommitTasks(commits []*gogithub.RepositoryCommit) []report.Task {
+	var repoOrder []string
+	byRepo := make(map[string][]*gogithub.RepositoryCommit)
+	for _, commit := range commits {
+		if commit.HTMLURL == nil || commit.Commit == nil {
+			continue
+		}
+		repoName := extractRepoName(*commit.HTMLURL)
+		if _, ok := byRepo[repoName]; !ok {
+			repoOrder = append(repoOrder, repoName)
+		}
+		byRepo[repoName] = append(byRepo[repoName], commit)
+	}
+
+	var tasks []report.Task
+	for _, repoName := range repoOrder {
+		repoCommits := byRepo[repoName]
+
+		var first, last time.Time
+		var summaries, messages []string
+		metrics := &report.CodeMetrics{Commits: len(repoCommits)}
+		for _, commit := range repoCommits {
+			date := commit.Commit.GetAuthor().GetDate().Time
+			if first.IsZero() || date.Before(first) {
+				first = date
+			}
+			if date.After(last) {
+				last = date
+			}
+
+			msg := cleanActivityText(commit.Commit.GetMessage())
+			sha := commit.GetSHA()
+			summaries = append(summaries, fmt.Sprintf("- %s (%s)", firstLine(msg), sha[:min(7, len(sha))]))
+			if !shouldSkipCommitMessage(msg) {
+				messages = append(messages, msg)
+			}
+
+			metrics.Additions += commit.GetStats().GetAdditions()
+			metrics.Deletions += commit.GetStats().GetDeletions()
+			metrics.FilesChanged += len(commit.Files)
+		}
+
+		title := fmt.Sprintf("%d commits pushed to %s", len(repoCommits), repoName)
+		if len(repoCommits) == 1 {
+			title = firstLine(repoCommits[0].Commit.GetMessage())
+		}
+		achievementInput := title
+		if len(messages) > 0 {
+			achievementInput = strings.Join(messages, "\n\n")
+		}
+
+		completedAt := last
+		tasks = append(tasks, report.Task{
+			ID:           "commits:" + repoName,
+			Title:        title,
+			Description:  strings.Join(summaries, "\n"),
+			Achievements: g.rephraseCommit(achievementInput),
+			Status:       "committed",
+			URL:          repoCommitsURL(repoCommits[0].GetHTMLURL(), g.Client.username),
+			CreatedAt:    first,
+			UpdatedAt:    last,
+			CompletedAt:  &completedAt,
+			Source:       repoName,
+			Type:         "Commit",
+			Assignee:     g.Client.username,
+			Metrics:      metrics,
+		})
+	}
+
+	return tasks
+}
+
+// repoCommitsURL turns a commit URL such as https://github.com/org/repo/commit/<sha>
+// into the repository's commit list filtered to the author.
+func repoCommitsURL(commitURL, author string) string {
+	i := strings.LastIndex(commitURL, "/commit/")
+	if i < 0 {
+		return commitURL
+	}
+	return commitURL[:i] + "/commits?author=" + url.QueryEscape(author)
+}
+
+// prStatus distinguishes draft, open, merged and closed-unmerged PRs,
+// which the API reports only as "open" or "closed".
+func prStatus(pr *gogithub.PullRequest) string {
+	switch {
+	case pr.MergedAt != nil:
+		return report.StatusMerged
+	case pr.GetState() == "closed":
+		return report.StatusClosed
+	case pr.GetDraft():
+		return report.StatusDraft
+	default:
+		return report.StatusOpen
+	}
+}
+
+// prCategory maps a PR to a status category: drafts are in progress, open PRs
+// await review, and closed-unmerged PRs were cancelled.
+func prCategory(pr *gogithub.PullRequest) string {
+	switch prStatus(pr) {
+	case report.StatusMerged:
+		return report.CategoryDone
+	case report.StatusClosed:
+		return report.CategoryCancelled
+	case report.StatusDraft:
+		return report.CategoryInProgress
+	default:
+		return report.CategoryReview
+	}
+}
+
+// issueCategory maps an issue to a status category; issues closed as not
+// planned were cancelled.
+func issueCategory(issue *gogithub.Issue) string {
+	if issue.GetState() != "closed" {
+		return report.CategoryTodo
+	}
+	if issue.GetStateReason() == "not_planned" {
+		return report.CategoryCancelled
+	}
+	return report.CategoryDone
+}
+
+// prMetrics reports the size of a PR. The commit count falls back to the
+// fetched commits when the PR itself does not carry one.
+func prMetrics(pr *gogithub.PullRequest, commits []*gogithub.RepositoryCommit) *report.CodeMetrics {
+	if pr.Additions == nil && pr.Deletions == nil && pr.ChangedFiles == nil && len(commits) == 0 {
+		return nil
+	}
+	commitCount := pr.GetCommits()
+	if commitCount == 0 {
+		commitCount = len(commits)
+	}
+	return &report.CodeMetrics{
+		Additions:    pr.GetAdditions(),
+		Deletions:    pr.GetDeletions(),
+		FilesChanged: pr.GetChangedFiles(),
+		Commits:      commitCount,
+	}
+}
+
+// reviewVerdict returns the outcome of the user's latest decisive review.
+// A PR that only received comments is reported as "commented".
+func reviewVerdict(reviews []*gogithub.PullRequestReview) string {
+	verdict := "commented"
+	var latest time.Time
+	for _, r := range reviews {
+		if r.State == nil || r.SubmittedAt == nil || r.SubmittedAt.Before(latest) {
+			continue
+		}
+		switch *r.State {
+		case "APPROVED":
+			verdict = "approved"
+			latest = r.SubmittedAt.Time
+		case "CHANGES_REQUESTED":
+			verdict = "changes requested"
+			latest = r.SubmittedAt.Time
+		}
+	}
+	return verdict
+}
+
+func reviewWindow(reviews []*gogithub.PullRequestReview) (time.Time, time.Time) {
+	var first, last time.Time
+	for _, r := range reviews {
+		if r.SubmittedAt == nil {
+			continue
+		}
+		if first.IsZero() || r.SubmittedAt.Before(first) {
+			first = r.SubmittedAt.Time
+		}
+		if r.SubmittedAt.After(last) {
+			last = r.SubmittedAt.Time
+		}
+	}
+	return first, last
+}
+
+func buildReviewAchievement(number int, title, verdict string, count int) string {
+	plural := "review"
+	if count != 1 {
+		plural = "reviews"
+	}
+	return fmt.Sprintf("Reviewed pull request #%d \"%s\" — %s (%d %s)", number, title, verdict, count, plural)
+}
+
+func buildDiscussionAchievement(kind string, number int, title string, count int) string {
+	plural := "comment"
+	if count != 1 {
+		plural = "comments"
+	}
+	return fmt.Sprintf("Discussed %s #%d \"%s\" (%d %s)", kind, number, title, count, plural)
+}
+
+// discussionWindow returns the times of the first and last comment and the
+// comment bodies in chronological order.
+func discussionWindow(d *Discussion) (time.Time, time.Time, string) {
+	type comment struct {
+		at   time.Time
+		body string
+	}
+	var comments []comment
+	for _, c := range d.IssueComments {
+		comments = append(comments, comment{c.GetCreatedAt().Time, c.GetBody()})
+	}
+	for _, c := range d.ReviewComments {
+		comments = append(comments, comment{c.GetCreatedAt().Time, c.GetBody()})
+	}
+	sort.Slice(comments, func(i, j int) bool {
+		return comments[i].at.Before(comments[j].at)
+	})
+
+	var bodies []string
+	for _, c := range comments {
+		if body := cleanActivityText(c.body); body != "" {
+			bodies = append(bodies, body)
+		}
+	}
+	if len(comments) == 0 {
+		return time.Time{}, time.Time{}, ""
+	}
+	return comments[0].at, comments[len(comments)-1].at, strings.Join(bodies, "\n\n")
+}
+
 // buildAchievementInput returns the best available text to feed into Ollama.
 // Priority: PR body > commit messages > PR title only.
 func buildAchievementInput(title, body string, commits []*gogithub.RepositoryCommit) string {
@@ -185,8 +580,17 @@ func cleanActivityText(value string) string {
 	return strings.TrimSpace(strings.Join(lines, "\n"))
 }
 
+// extractRepoName returns the repository name from a web URL such as
+// https://github.com/org/repo/pull/1. The segment before the resource kind is used,
+// so Enterprise Server instances hosted under a path prefix resolve correctly.
 func extractRepoName(url string) string {
-	parts := strings.Split(url, "/")
+	parts := strings.Split(strings.TrimSuffix(url, "/"), "/")
+	for i := len(parts) - 1; i >= 4; i-- {
+		switch parts[i] {
+		case "pull", "issues", "commit", "releases", "tree":
+			return parts[i-1]
+		}
+	}
 	if len(parts) >= 5 {
 		return parts[4]
 	}
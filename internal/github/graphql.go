@@ -0,0 +1,466 @@
+package github
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v60/github"
+)
+
+const (
+	APIREST    = "rest"
+	APIGraphQL = "graphql"
+
+	defaultGraphQLURL = "https://api.github.com/graphql"
+
+	// contributionsCollection only accepts ranges of up to one year
+	maxContributionSpan = 365 * 24 * time.Hour
+)
+
+const prContributionsQuery = `query($login: String!, $from: DateTime!, $to: DateTime!, $after: String) {
+  user(login: $login) {
+    contributionsCollection(from: $from, to: $to) {
+      pullRequestContributions(first: 25, after: $after) {
+        pageInfo { hasNextPage endCursor }
+        nodes {
+          pullRequest {
+            number title body url state isDraft
+            createdAt updatedAt closedAt mergedAt
+            additions deletions changedFiles
+            baseRefName
+            mergeCommit { oid }
+            author { login }
+            repository { name owner { login } }
+            commits(first: 100) {
+              totalCount
+              nodes { commit { oid message } }
+            }
+          }
+        }
+      }
+    }
+  }
+}`
+
+const reviewContributionsQuery = `query($login: String!, $from: DateTime!, $to: DateTime!, $after: String) {
+  user(login: $login) {
+    contributionsCollection(from: $from, to: $to) {
+      pullRequestReviewContributions(first: 100, after: $after) {
+        pageInfo { hasNextPage endCursor }
+        nodes {
+          pullRequestReview {
+            state submittedAt
+            author { login }
+            pullRequest {
+              number title url createdAt baseRefName
+              author { login }
+              repository { name owner { login } }
+            }
+          }
+        }
+      }
+    }
+  }
+}`
+
+const issueContributionsQuery = `query($login: String!, $from: DateTime!, $to: DateTime!, $after: String) {
+  user(login: $login) {
+    contributionsCollection(from: $from, to: $to) {
+      issueContributions(first: 100, after: $after) {
+        pageInfo { hasNextPage endCursor }
+        nodes {
+          issue {
+            number title body url state
+            createdAt updatedAt closedAt
+            repository { name owner { login } }
+          }
+        }
+      }
+    }
+  }
+}`
+
+type gqlPageInfo struct {
+	HasNextPage bool   `json:"hasNextPage"`
+	EndCursor   string `json:"endCursor"`
+}
+
+type gqlActor struct {
+	Login string `json:"login"`
+}
+
+type gqlRepository struct {
+	Name  string   `json:"name"`
+	Owner gqlActor `json:"owner"`
+}
+
+type gqlPullRequest struct {
+	Number       int           `json:"number"`
+	Title        string        `json:"title"`
+	Body         string        `json:"body"`
+	URL          string        `json:"url"`
+	State        string        `json:"state"`
+	IsDraft      bool          `json:"isDraft"`
+	CreatedAt    time.Time     `json:"createdAt"`
+	UpdatedAt    time.Time     `json:"updatedAt"`
+	ClosedAt     *time.Time    `json:"closedAt"`
+	MergedAt     *time.Time    `json:"mergedAt"`
+	Additions    int           `json:"additions"`
+	Deletions    int           `json:"deletions"`
+	ChangedFiles int           `json:"changedFiles"`
+	BaseRefName  string        `json:"baseRefName"`
+	MergeCommit  *gqlCommitOID `json:"mergeCommit"`
+	Author       *gqlActor     `json:"author"`
+	Repository   gqlRepository `json:"repository"`
+	Commits      struct {
+		TotalCount int `json:"totalCount"`
+		Nodes      []struct {
+			Commit struct {
+				OID     string `json:"oid"`
+				Message string `json:"message"`
+			} `json:"commit"`
+		} `json:"nodes"`
+	} `json:"commits"`
+}
+
+type gqlCommitOID struct {
+	OID string `json:"oid"`
+}
+
+type gqlReview struct {
+	State       string         `json:"state"`
+	SubmittedAt *time.Time     `json:"submittedAt"`
+	Author      *gqlActor      `json:"author"`
+	PullRequest gqlPullRequest `json:"pullRequest"`
+}
+
+type gqlIssue struct {
+	Number     int           `json:"number"`
+	Title      string        `json:"title"`
+	Body       string        `json:"body"`
+	URL        string        `json:"url"`
+	State      string        `json:"state"`
+	CreatedAt  time.Time     `json:"createdAt"`
+	UpdatedAt  time.Time     `json:"updatedAt"`
+	ClosedAt   *time.Time    `json:"closedAt"`
+	Repository gqlRepository `json:"repository"`
+}
+
+type gqlConnection[T any] struct {
+	PageInfo gqlPageInfo `json:"pageInfo"`
+	Nodes    []T         `json:"nodes"`
+}
+
+type gqlContributions struct {
+	PullRequestContributions gqlConnection[struct {
+		PullRequest gqlPullRequest `json:"pullRequest"`
+	}] `json:"pullRequestContributions"`
+	PullRequestReviewContributions gqlConnection[struct {
+		PullRequestReview gqlReview `json:"pullRequestReview"`
+	}] `json:"pullRequestReviewContributions"`
+	IssueContributions gqlConnection[struct {
+		Issue gqlIssue `json:"issue"`
+	}] `json:"issueContributions"`
+}
+
+type gqlContributionsResponse struct {
+	Data struct {
+		User *struct {
+			ContributionsCollection gqlContributions `json:"contributionsCollection"`
+		} `json:"user"`
+	} `json:"data"`
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+// fetchContributions pages through one connection of the user's contributionsCollection,
+// calling visit for every page. The range is split into windows the API accepts.
+func (c *Client) fetchContributions(ctx context.Context, query string, start, end time.Time, visit func(gqlContributions) gqlPageInfo) error {
+	for _, window := range contributionWindows(start, end) {
+		var after *string
+		for {
+			vars := map[string]any{
+				"login": c.username,
+				"from":  window[0].UTC().Format(time.RFC3339),
+				"to":    window[1].UTC().Format(time.RFC3339),
+				"after": after,
+			}
+
+			var resp gqlContributionsResponse
+			if err := c.graphql(ctx, query, vars, &resp); err != nil {
+				return err
+			}
+			if len(resp.Errors) > 0 {
+				return fmt.Errorf("GraphQL error: %s", resp.Errors[0].Message)
+			}
+			if resp.Data.User == nil {
+				return fmt.Errorf("GitHub user %q not found", c.username)
+			}
+
+			page := visit(resp.Data.User.ContributionsCollection)
+			if !page.HasNextPage || page.EndCursor == "" {
+				break
+			}
+			cursor := page.EndCursor
+			after = &cursor
+		}
+	}
+	return nil
+}
+
+func (c *Client) fetchPRsWithCommitsGraphQL(ctx context.Context, start, end time.Time) ([]*PRWithCommits, error) {
+	var results []*PRWithCommits
+	seen := make(map[string]bool)
+
+	err := c.fetchContributions(ctx, prContributionsQuery, start, end, func(cc gqlContributions) gqlPageInfo {
+		conn := cc.PullRequestContributions
+		for _, node := range conn.Nodes {
+			pr := node.PullRequest
+			if seen[pr.URL] || !c.coversRepository(pr.Repository) || !c.matchesBranch(pr.BaseRefName) {
+				continue
+			}
+			seen[pr.URL] = true
+
+			var mergeSHA string
+			if pr.MergeCommit != nil {
+				mergeSHA = pr.MergeCommit.OID
+			}
+			owner, repo := pr.Repository.Owner.Login, pr.Repository.Name
+
+			var commits []*github.RepositoryCommit
+			if len(c.branches) == 0 || (pr.MergedAt != nil && c.onBranch(ctx, owner, repo, pr.BaseRefName, mergeSHA)) {
+				for _, n := range pr.Commits.Nodes {
+					commits = append(commits, &github.RepositoryCommit{
+						SHA:    github.String(n.Commit.OID),
+						Commit: &github.Commit{Message: github.String(n.Commit.Message)},
+					})
+				}
+
+				// the query returns the first 100 commits; larger PRs are listed over REST
+				if pr.Commits.TotalCount > len(pr.Commits.Nodes) {
+					all, err := c.fetchPRCommits(ctx, owner, repo, pr.Number)
+					if err != nil {
+						fmt.Printf("Warning: could not fetch all %d commits of PR %s/%s#%d: %v\n", pr.Commits.TotalCount, owner, repo, pr.Number, err)
+					} else {
+						commits = all
+					}
+				}
+			}
+
+			results = append(results, &PRWithCommits{
+				PR:      pr.toREST(),
+				Commits: commits,
+			})
+		}
+		return conn.PageInfo
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+func (c *Client) fetchReviewedPRsGraphQL(ctx context.Context, start, end time.Time) ([]*ReviewedPR, error) {
+	var results []*ReviewedPR
+	byURL := make(map[string]*ReviewedPR)
+
+	err := c.fetchContributions(ctx, reviewContributionsQuery, start, end, func(cc gqlContributions) gqlPageInfo {
+		conn := cc.PullRequestReviewContributions
+		for _, node := range conn.Nodes {
+			review := node.PullRequestReview
+			pr := review.PullRequest
+			if review.SubmittedAt == nil || !c.coversRepository(pr.Repository) || !c.matchesBranch(pr.BaseRefName) {
+				continue
+			}
+			if pr.Author != nil && strings.EqualFold(pr.Author.Login, c.username) {
+				continue
+			}
+			switch review.State {
+			case "APPROVED", "CHANGES_REQUESTED", "COMMENTED":
+			default:
+				continue
+			}
+
+			entry, ok := byURL[pr.URL]
+			if !ok {
+				entry = &ReviewedPR{PR: c.gqlPRToIssue(pr)}
+				byURL[pr.URL] = entry
+				results = append(results, entry)
+			}
+			entry.Reviews = append(entry.Reviews, &github.PullRequestReview{
+				State:       github.String(review.State),
+				SubmittedAt: &github.Timestamp{Time: *review.SubmittedAt},
+				User:        &github.User{Login: github.String(c.username)},
+			})
+		}
+		return conn.PageInfo
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+func (c *Client) fetchIssuesGraphQL(ctx context.Context, start, end time.Time) ([]*github.Issue, error) {
+	var results []*github.Issue
+	seen := make(map[string]bool)
+
+	err := c.fetchContributions(ctx, issueContributionsQuery, start, end, func(cc gqlContributions) gqlPageInfo {
+		conn := cc.IssueContributions
+		for _, node := range conn.Nodes {
+			issue := node.Issue
+			if seen[issue.URL] || !c.coversRepository(issue.Repository) {
+				continue
+			}
+			seen[issue.URL] = true
+
+			rest := &github.Issue{
+				Number:        github.Int(issue.Number),
+				Title:         github.String(issue.Title),
+				Body:          github.String(issue.Body),
+				HTMLURL:       github.String(issue.URL),
+				State:         github.String(strings.ToLower(issue.State)),
+				CreatedAt:     &github.Timestamp{Time: issue.CreatedAt},
+				UpdatedAt:     &github.Timestamp{Time: issue.UpdatedAt},
+				RepositoryURL: github.String(c.repositoryAPIURL(issue.Repository)),
+			}
+			if issue.ClosedAt != nil {
+				rest.ClosedAt = &github.Timestamp{Time: *issue.ClosedAt}
+			}
+			results = append(results, rest)
+		}
+		return conn.PageInfo
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// coversRepository reports whether a repository belongs to the configured orgs and,
+// when set, to the configured repository list.
+func (c *Client) coversRepository(repo gqlRepository) bool {
+	return c.coversRepo(repo.Owner.Login, repo.Name)
+}
+
+func (c *Client) repositoryAPIURL(repo gqlRepository) string {
+	return fmt.Sprintf("%srepos/%s/%s", c.client.BaseURL.String(), repo.Owner.Login, repo.Name)
+}
+
+func (c *Client) gqlPRToIssue(pr gqlPullRequest) *github.Issue {
+	return &github.Issue{
+		Number:        github.Int(pr.Number),
+		Title:         github.String(pr.Title),
+		HTMLURL:       github.String(pr.URL),
+		CreatedAt:     &github.Timestamp{Time: pr.CreatedAt},
+		RepositoryURL: github.String(c.repositoryAPIURL(pr.Repository)),
+	}
+}
+
+// toREST converts a GraphQL pull request into the REST shape the source maps into tasks.
+func (pr gqlPullRequest) toREST() *github.PullRequest {
+	state := "open"
+	if pr.State != "OPEN" {
+		state = "closed"
+	}
+
+	rest := &github.PullRequest{
+		Number:       github.Int(pr.Number),
+		Title:        github.String(pr.Title),
+		Body:         github.String(pr.Body),
+		HTMLURL:      github.String(pr.URL),
+		State:        github.String(state),
+		Draft:        github.Bool(pr.IsDraft),
+		CreatedAt:    &github.Timestamp{Time: pr.CreatedAt},
+		UpdatedAt:    &github.Timestamp{Time: pr.UpdatedAt},
+		Additions:    github.Int(pr.Additions),
+		Deletions:    github.Int(pr.Deletions),
+		ChangedFiles: github.Int(pr.ChangedFiles),
+		Commits:      github.Int(pr.Commits.TotalCount),
+		Base: &github.PullRequestBranch{
+			Ref: github.String(pr.BaseRefName),
+			Repo: &github.Repository{
+				Name:  github.String(pr.Repository.Name),
+				Owner: &github.User{Login: github.String(pr.Repository.Owner.Login)},
+			},
+		},
+	}
+	if pr.Author != nil {
+		rest.User = &github.User{Login: github.String(pr.Author.Login)}
+	}
+	if pr.ClosedAt != nil {
+		rest.ClosedAt = &github.Timestamp{Time: *pr.ClosedAt}
+	}
+	if pr.MergedAt != nil {
+		rest.MergedAt = &github.Timestamp{Time: *pr.MergedAt}
+	}
+	if pr.MergeCommit != nil {
+		rest.MergeCommitSHA = github.String(pr.MergeCommit.OID)
+	}
+	return rest
+}
+
+func (c *Client) graphql(ctx context.Context, query string, vars map[string]any, out any) error {
+	payload, err := json.Marshal(map[string]any{"query": query, "variables": vars})
+	if err != nil {
+		return fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	for attempt := 0; ; attempt++ {
+		req, err := http.NewRequestWithContext(ctx, "POST", c.graphqlURL, bytes.NewReader(payload))
+		if err != nil {
+			return fmt.Errorf("failed to create request: %w", err)
+		}
+		req.Header.Set("Content-Type", "application/json")
+
+		resp, err := c.httpClient.Do(req)
+		if err != nil {
+			return fmt.Errorf("request failed: %w", err)
+		}
+
+		// a 403 is only a rate limit when it says so; otherwise it is SSO
+		// enforcement or missing access, which a retry will not fix
+		rateLimited := resp.StatusCode == 403 && (resp.Header.Get("Retry-After") != "" || resp.Header.Get("X-RateLimit-Remaining") == "0")
+		if (rateLimited || resp.StatusCode == 429 || resp.StatusCode >= 500) && attempt < maxRateLimitRetries {
+			resp.Body.Close()
+			c.handleRateLimitWithRetry(attempt + 1)
+			continue
+		}
+
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return fmt.Errorf("failed to read response: %w", err)
+		}
+		if resp.StatusCode != http.StatusOK {
+			return fmt.Errorf("GraphQL API error %d: %s", resp.StatusCode, string(body))
+		}
+
+		if err := json.Unmarshal(body, out); err != nil {
+			return fmt.Errorf("failed to decode response: %w", err)
+		}
+		return nil
+	}
+}
+
+func contributionWindows(start, end time.Time) [][2]time.Time {
+	var windows [][2]time.Time
+	for from := start; from.Before(end); from = from.Add(maxContributionSpan) {
+		to := from.Add(maxContributionSpan)
+		if to.After(end) {
+			to = end
+		}
+		windows = append(windows, [2]time.Time{from, to})
+	}
+	return windows
+}
@@ -0,0 +1,134 @@
+package github
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// rateReserve is how many requests per resource are left untouched for other
+// tools sharing the same token.
+const rateReserve = 10
+
+// rateBudget tracks the request allowance GitHub reports on every response so
+// concurrent workers pause before exhausting it rather than after a 403.
+// Budgets are kept per rate-limit resource (core, search, graphql).
+type rateBudget struct {
+	mu        sync.Mutex
+	remaining map[string]int
+	reset     map[string]time.Time
+}
+
+func newRateBudget() *rateBudget {
+	return &rateBudget{
+		remaining: make(map[string]int),
+		reset:     make(map[string]time.Time),
+	}
+}
+
+// acquire blocks until a request against resource fits in the known budget.
+func (b *rateBudget) acquire(resource string) {
+	for {
+		b.mu.Lock()
+		remaining, known := b.remaining[resource]
+		if !known || remaining > rateReserve {
+			if known {
+				b.remaining[resource] = remaining - 1
+			}
+			b.mu.Unlock()
+			return
+		}
+
+		wait := time.Until(b.reset[resource])
+		if wait <= 0 {
+			delete(b.remaining, resource)
+			b.mu.Unlock()
+			return
+		}
+		b.mu.Unlock()
+
+		fmt.Printf("GitHub %s rate budget low (%d left). Waiting %v until reset...\n", resource, remaining, wait.Round(time.Second))
+		time.Sleep(wait)
+	}
+}
+
+// update records the allowance reported by a response.
+func (b *rateBudget) update(resource string, header http.Header) {
+	remaining, err := strconv.Atoi(header.Get("X-RateLimit-Remaining"))
+	if err != nil {
+		return
+	}
+	reset, err := strconv.ParseInt(header.Get("X-RateLimit-Reset"), 10, 64)
+	if err != nil {
+		return
+	}
+	if r := header.Get("X-RateLimit-Resource"); r != "" {
+		resource = r
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.remaining[resource] = remaining
+	b.reset[resource] = time.Unix(reset, 0)
+}
+
+// budgetTransport consults the shared budget before each request and refreshes it from each response.
+type budgetTransport struct {
+	base   http.RoundTripper
+	budget *rateBudget
+}
+
+func (t *budgetTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resource := rateResource(req.URL.Path)
+	t.budget.acquire(resource)
+
+	resp, err := t.base.RoundTrip(req)
+	if err == nil {
+		t.budget.update(resource, resp.Header)
+	}
+	return resp, err
+}
+
+func rateResource(path string) string {
+	switch {
+	case strings.Contains(path, "/search/"):
+		return "search"
+	case strings.HasSuffix(path, "/graphql"):
+		return "graphql"
+	default:
+		return "core"
+	}
+}
+
+// forEach runs fn for every index in [0, n) on at most c.concurrency workers.
+// Callers write results into index-addressed slots so output order stays deterministic.
+func (c *Client) forEach(n int, fn func(i int)) {
+	workers := c.concurrency
+	if workers <= 0 {
+		workers = defaultConcurrency
+	}
+	if workers > n {
+		workers = n
+	}
+
+	jobs := make(chan int, n)
+	for i := 0; i < n; i++ {
+		jobs <- i
+	}
+	close(jobs)
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				fn(i)
+			}
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,218 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v60/github"
+)
+
+// TagCreation is a tag the user pushed to a covered repository.
+type TagCreation struct {
+	Owner     string
+	Repo      string
+	Tag       string
+	URL       string
+	CreatedAt time.Time
+}
+
+// FetchReleases fetches releases the user published in the covered repositories
+// within the date range. Drafts are skipped. Without specific repositories the
+// releases are read from the user's event feed, which only reaches back 90 days,
+// rather than listing the releases of every org repository.
+func (c *Client) FetchReleases(ctx context.Context, start, end time.Time) ([]*github.RepositoryRelease, error) {
+	if !c.includeReleases {
+		return nil, nil
+	}
+	if len(c.repos) == 0 {
+		return c.releaseEvents(ctx, start, end)
+	}
+
+	repos, err := c.coveredRepos(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	perRepo := make([][]*github.RepositoryRelease, len(repos))
+	c.forEach(len(repos), func(i int) {
+		owner, name := repos[i].GetOwner().GetLogin(), repos[i].GetName()
+		releases, err := c.fetchReleasesInRepo(ctx, owner, name, start, end)
+		if err != nil {
+			fmt.Printf("Warning: could not fetch releases for %s/%s: %v\n", owner, name, err)
+			return
+		}
+		perRepo[i] = releases
+	})
+
+	var results []*github.RepositoryRelease
+	for _, releases := range perRepo {
+		results = append(results, releases...)
+	}
+
+	return results, nil
+}
+
+func (c *Client) fetchReleasesInRepo(ctx context.Context, owner, repo string, start, end time.Time) ([]*github.RepositoryRelease, error) {
+	var releases []*github.RepositoryRelease
+
+	opts := &github.ListOptions{PerPage: 100}
+	for {
+		result, resp, err := c.client.Repositories.ListReleases(ctx, owner, repo, opts)
+		if err != nil {
+			if rateErr := c.handleRateLimit(resp, err); rateErr != nil {
+				return nil, rateErr
+			}
+			return nil, err
+		}
+
+		// the listing is not strictly ordered by date (drafts, backdated tags),
+		// so every page is read rather than stopping at the first older release
+		for _, release := range result {
+			if release.GetDraft() || release.PublishedAt == nil {
+				continue
+			}
+			if release.PublishedAt.Before(start) || release.PublishedAt.After(end) {
+				continue
+			}
+			if !strings.EqualFold(release.GetAuthor().GetLogin(), c.username) {
+				continue
+			}
+			releases = append(releases, release)
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	return releases, nil
+}
+
+// releaseEvents returns the releases the user published in the covered orgs,
+// read from the published release events of the user's feed.
+func (c *Client) releaseEvents(ctx context.Context, start, end time.Time) ([]*github.RepositoryRelease, error) {
+	events, err := c.userEvents(ctx, "ReleaseEvent", start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	var releases []*github.RepositoryRelease
+	for _, event := range events {
+		payload, err := event.ParsePayload()
+		if err != nil {
+			continue
+		}
+		published, ok := payload.(*github.ReleaseEvent)
+		if !ok || published.GetAction() != "published" {
+			continue
+		}
+		release := published.GetRelease()
+		if release == nil || release.GetDraft() || release.PublishedAt == nil {
+			continue
+		}
+		if release.PublishedAt.Before(start) || release.PublishedAt.After(end) {
+			continue
+		}
+
+		owner, repo, found := strings.Cut(event.GetRepo().GetName(), "/")
+		if !found || !c.coversRepo(owner, repo) {
+			continue
+		}
+		releases = append(releases, release)
+	}
+
+	return releases, nil
+}
+
+// FetchTags returns tags the user created in the covered orgs and repositories.
+// Tags carry no author, so they are read from the user's event feed, which
+// only reaches back 90 days.
+func (c *Client) FetchTags(ctx context.Context, start, end time.Time) ([]*TagCreation, error) {
+	if !c.includeTags {
+		return nil, nil
+	}
+
+	events, err := c.userEvents(ctx, "CreateEvent", start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	var tags []*TagCreation
+	for _, event := range events {
+		payload, err := event.ParsePayload()
+		if err != nil {
+			continue
+		}
+		create, ok := payload.(*github.CreateEvent)
+		if !ok || create.GetRefType() != "tag" {
+			continue
+		}
+
+		owner, repo, found := strings.Cut(event.GetRepo().GetName(), "/")
+		if !found || !c.coversRepo(owner, repo) {
+			continue
+		}
+
+		tags = append(tags, &TagCreation{
+			Owner:     owner,
+			Repo:      repo,
+			Tag:       create.GetRef(),
+			URL:       fmt.Sprintf("%s/%s/%s/releases/tag/%s", c.webURL(), owner, repo, url.PathEscape(create.GetRef())),
+			CreatedAt: event.CreatedAt.Time,
+		})
+	}
+
+	return tags, nil
+}
+
+// userEvents returns the events of one type the user performed within the
+// date range, newest first.
+func (c *Client) userEvents(ctx context.Context, eventType string, start, end time.Time) ([]*github.Event, error) {
+	var found []*github.Event
+	opts := &github.ListOptions{PerPage: 100}
+	for {
+		events, resp, err := c.client.Activity.ListEventsPerformedByUser(ctx, c.username, false, opts)
+		if err != nil {
+			if rateErr := c.handleRateLimit(resp, err); rateErr != nil {
+				return nil, rateErr
+			}
+			return nil, err
+		}
+
+		// events are listed newest first
+		for _, event := range events {
+			if event.CreatedAt == nil {
+				continue
+			}
+			if event.CreatedAt.Before(start) {
+				return found, nil
+			}
+			if event.GetType() != eventType || event.CreatedAt.After(end) {
+				continue
+			}
+			found = append(found, event)
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	return found, nil
+}
+
+// webURL returns the web root matching the API base URL: https://github.com for
+// api.github.com, or the Enterprise Server host for .../api/v3/.
+func (c *Client) webURL() string {
+	u := *c.client.BaseURL
+	if u.Host == "api.github.com" {
+		u.Host = "github.com"
+	}
+	u.Path = strings.TrimSuffix(strings.TrimSuffix(u.Path, "/"), "/api/v3")
+	return strings.TrimSuffix(u.String(), "/")
+}
@@ -0,0 +1,93 @@
+package github
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Afrawles/devreport/internal/llm"
+)
+
+// rephraseCommit takes a git commit message and rephrases it as a professional achievement.
+func (g *GitHubSource) rephraseCommit(message string) string {
+	if g.Rephraser == nil || strings.TrimSpace(message) == "" {
+		return message
+	}
+
+	prompt := llm.Prompt{
+		Subject: "git commit message",
+		Label:   "Commit message",
+		Length:  "one sentence max",
+	}.Build(message)
+
+	rephrased, err := g.Rephraser.Rephrase(prompt)
+	if err != nil {
+		fmt.Printf("LLM unavailable for commit rephrase: %v\n", err)
+		return message
+	}
+
+	fmt.Printf("Rephrased commit: %s -> %s\n", firstLine(message), rephrased)
+	return rephrased
+}
+
+// rephrasePR takes a PR title and body and rephrases it as a professional achievement.
+func (g *GitHubSource) rephrasePR(title, body string) string {
+	input := title
+	if strings.TrimSpace(body) != "" {
+		input = title + "\n\n" + body
+	}
+
+	if strings.TrimSpace(input) == "" {
+		return input
+	}
+
+	if g.Rephraser == nil {
+		return title
+	}
+
+	prompt := llm.Prompt{
+		Subject: "pull request title and description",
+		Label:   "Pull request",
+		Length:  "one to two sentences max",
+	}.Build(input)
+
+	rephrased, err := g.Rephraser.Rephrase(prompt)
+	if err != nil {
+		fmt.Printf("LLM unavailable for PR rephrase: %v\n", err)
+		return title
+	}
+
+	fmt.Printf("Rephrased PR: %s -> %s\n", title, rephrased)
+	return rephrased
+}
+
+// rephraseRelease takes a release name and its notes and rephrases them as a professional achievement.
+func (g *GitHubSource) rephraseRelease(name, notes string) string {
+	input := name
+	if strings.TrimSpace(notes) != "" {
+		input = name + "\n\n" + notes
+	}
+
+	if strings.TrimSpace(input) == "" {
+		return input
+	}
+
+	if g.Rephraser == nil {
+		return "Released " + name
+	}
+
+	prompt := llm.Prompt{
+		Subject: "software release name and release notes",
+		Label:   "Release",
+		Focus:   "what was shipped",
+		Length:  "one to two sentences max",
+	}.Build(input)
+
+	rephrased, err := g.Rephraser.Rephrase(prompt)
+	if err != nil {
+		fmt.Printf("LLM unavailable for release rephrase: %v\n", err)
+		return "Released " + name
+	}
+
+	fmt.Printf("Rephrased release: %s -> %s\n", name, rephrased)
+	return rephrased
+}
@@ -0,0 +1,119 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v60/github"
+)
+
+// searchResultCap is the most results the Search API returns for a single query.
+const searchResultCap = 1000
+
+// searchIssues runs an issue/PR search in every configured scope, restricted to
+// dateField (created, updated, closed) falling within the range. Windows holding
+// more results than the API will return are split in half until they fit.
+func (c *Client) searchIssues(ctx context.Context, qualifiers, dateField string, start, end time.Time) ([]*github.Issue, error) {
+	var results []*github.Issue
+	seen := make(map[string]bool)
+
+	for _, scope := range c.searchScopes() {
+		base := qualifiers + " " + scope
+		if err := c.searchWindow(ctx, base, dateField, start, end, seen, &results); err != nil {
+			return nil, err
+		}
+	}
+
+	return results, nil
+}
+
+func (c *Client) searchWindow(ctx context.Context, base, dateField string, start, end time.Time, seen map[string]bool, results *[]*github.Issue) error {
+	query := fmt.Sprintf("%s %s:%s..%s", base, dateField, formatSearchTime(start), formatSearchTime(end))
+
+	opts := &github.SearchOptions{
+		ListOptions: github.ListOptions{PerPage: 100},
+	}
+	// a stable sort keeps pagination consistent; the API cannot sort by closed date
+	if dateField == "created" || dateField == "updated" {
+		opts.Sort = dateField
+		opts.Order = "asc"
+	}
+
+	retries := 0
+	for {
+		result, resp, err := c.client.Search.Issues(ctx, query, opts)
+		if err != nil {
+			if retries == maxRateLimitRetries {
+				return err
+			}
+			if rateErr := c.handleRateLimit(resp, err); rateErr != nil {
+				return rateErr
+			}
+			retries++
+			continue
+		}
+		retries = 0
+
+		if opts.Page == 0 && result.GetTotal() > searchResultCap && end.Sub(start) > time.Minute {
+			mid := start.Add(end.Sub(start) / 2).Truncate(time.Second)
+			fmt.Printf("  %d results for %q, splitting date range\n", result.GetTotal(), base)
+			if err := c.searchWindow(ctx, base, dateField, start, mid, seen, results); err != nil {
+				return err
+			}
+			return c.searchWindow(ctx, base, dateField, mid.Add(time.Second), end, seen, results)
+		}
+
+		for _, issue := range result.Issues {
+			if issue.HTMLURL == nil || seen[*issue.HTMLURL] {
+				continue
+			}
+			seen[*issue.HTMLURL] = true
+			*results = append(*results, issue)
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	return nil
+}
+
+// searchScopes returns one qualifier per search: org:<org> for every org, or
+// repo:<owner>/<name> for every configured repository.
+func (c *Client) searchScopes() []string {
+	var scopes []string
+	if len(c.repos) == 0 {
+		for _, org := range c.orgs {
+			scopes = append(scopes, "org:"+org)
+		}
+		return scopes
+	}
+
+	seen := make(map[string]bool)
+	for _, org := range c.orgs {
+		for _, repoName := range c.repos {
+			repoName = strings.TrimSpace(repoName)
+			if repoName == "" {
+				continue
+			}
+			full := org + "/" + repoName
+			if strings.Contains(repoName, "/") {
+				full = repoName
+			}
+			if seen[full] {
+				continue
+			}
+			seen[full] = true
+			scopes = append(scopes, "repo:"+full)
+		}
+	}
+	return scopes
+}
+
+func formatSearchTime(t time.Time) string {
+	return t.UTC().Format("2006-01-02T15:04:05Z")
+}
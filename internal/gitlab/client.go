@@ -0,0 +1,430 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+const (
+	defaultBaseURL = "https://gitlab.com"
+
+	requestsPerMinute = 300
+	burst             = 10
+	retryMax          = 5
+)
+
+type Client struct {
+	baseURL    string
+	token      string
+	groups     []string
+	projects   []string
+	username   string
+	httpClient *http.Client
+	limiter    *rate.Limiter
+}
+
+func NewClient(baseURL, token string, groups, projects []string, username string) *Client {
+	if baseURL == "" {
+		baseURL = defaultBaseURL
+	}
+
+	return &Client{
+		baseURL:    strings.TrimSuffix(baseURL, "/") + "/api/v4",
+		token:      token,
+		groups:     groups,
+		projects:   projects,
+		username:   username,
+		httpClient: &http.Client{Timeout: 30 * time.Second},
+		limiter:    rate.NewLimiter(rate.Every(time.Minute/requestsPerMinute), burst),
+	}
+}
+
+type User struct {
+	ID       int    `json:"id"`
+	Username string `json:"username"`
+	Name     string `json:"name"`
+}
+
+type References struct {
+	Full string `json:"full"`
+}
+
+type MergeRequest struct {
+	ID           int        `json:"id"`
+	IID          int        `json:"iid"`
+	ProjectID    int        `json:"project_id"`
+	Title        string     `json:"title"`
+	Description  string     `json:"description"`
+	State        string     `json:"state"`
+	Draft        bool       `json:"draft"`
+	WebURL       string     `json:"web_url"`
+	SourceBranch string     `json:"source_branch"`
+	TargetBranch string     `json:"target_branch"`
+	Labels       []string   `json:"labels"`
+	Author       User       `json:"author"`
+	References   References `json:"references"`
+	CreatedAt    time.Time  `json:"created_at"`
+	UpdatedAt    time.Time  `json:"updated_at"`
+	MergedAt     *time.Time `json:"merged_at"`
+	ClosedAt     *time.Time `json:"closed_at"`
+}
+
+// Diff is one changed file of a merge request.
+type Diff struct {
+	NewPath string `json:"new_path"`
+	Diff    string `json:"diff"`
+}
+
+type Commit struct {
+	ID string `json:"id"`
+}
+
+type Issue struct {
+	ID          int        `json:"id"`
+	IID         int        `json:"iid"`
+	ProjectID   int        `json:"project_id"`
+	Title       string     `json:"title"`
+	Description string     `json:"description"`
+	State       string     `json:"state"`
+	WebURL      string     `json:"web_url"`
+	Labels      []string   `json:"labels"`
+	Author      User       `json:"author"`
+	Assignees   []User     `json:"assignees"`
+	References  References `json:"references"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+	ClosedAt    *time.Time `json:"closed_at"`
+}
+
+func (c *Client) HealthCheck() error {
+	req, err := c.newRequest("/user", nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("API health check failed with status %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// FetchMergeRequests fetches merge requests authored by or assigned to the user and created in the date range.
+func (c *Client) FetchMergeRequests(start, end time.Time) ([]MergeRequest, error) {
+	var all []MergeRequest
+	seen := make(map[int]bool)
+
+	for _, filter := range []string{"author_username", "assignee_username"} {
+		q := url.Values{}
+		q.Set(filter, c.username)
+		q.Set("created_after", start.Format(time.RFC3339))
+		q.Set("created_before", end.Format(time.RFC3339))
+
+		for _, path := range c.scopedPaths("merge_requests") {
+			mrs, err := getAll[MergeRequest](c, path, q)
+			if err != nil {
+				return nil, err
+			}
+			for _, mr := range mrs {
+				if seen[mr.ID] {
+					continue
+				}
+				seen[mr.ID] = true
+				all = append(all, mr)
+			}
+		}
+	}
+
+	return all, nil
+}
+
+// FetchMergeRequestChanges fetches the file diffs and commits of a merge request.
+// The diffs endpoint needs GitLab 15.7 or later.
+func (c *Client) FetchMergeRequestChanges(mr MergeRequest) ([]Diff, []Commit, error) {
+	path := fmt.Sprintf("/projects/%d/merge_requests/%d", mr.ProjectID, mr.IID)
+
+	diffs, err := getAll[Diff](c, path+"/diffs", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	commits, err := getAll[Commit](c, path+"/commits", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return diffs, commits, nil
+}
+
+// ApprovedMergeRequest is a merge request the user approved.
+type ApprovedMergeRequest struct {
+	MergeRequest
+	ApprovedAt time.Time
+}
+
+type approvals struct {
+	ApprovedBy []struct {
+		User       User       `json:"user"`
+		ApprovedAt *time.Time `json:"approved_at"`
+	} `json:"approved_by"`
+}
+
+type Note struct {
+	Body      string    `json:"body"`
+	System    bool      `json:"system"`
+	Author    User      `json:"author"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// FetchApprovedMergeRequests fetches merge requests by other authors that the user approved
+// in the date range. Candidates are narrowed with the approver filter, which needs GitLab
+// Premium and may be ignored on other tiers, so each one is confirmed against its approvals.
+// MRs updated after the range are included, since an approval may be followed by more activity.
+func (c *Client) FetchApprovedMergeRequests(start, end time.Time) ([]ApprovedMergeRequest, error) {
+	q := url.Values{}
+	q.Add("approved_by_usernames[]", c.username)
+	q.Set("updated_after", start.Format(time.RFC3339))
+
+	var all []ApprovedMergeRequest
+	seen := make(map[int]bool)
+	for _, path := range c.scopedPaths("merge_requests") {
+		mrs, err := getAll[MergeRequest](c, path, q)
+		if err != nil {
+			return nil, err
+		}
+		for _, mr := range mrs {
+			if seen[mr.ID] || strings.EqualFold(mr.Author.Username, c.username) {
+				continue
+			}
+			seen[mr.ID] = true
+
+			approvedAt, ok, err := c.approvalTime(mr)
+			if err != nil {
+				fmt.Printf("Warning: could not fetch approvals for MR %s: %v\n", mr.WebURL, err)
+				continue
+			}
+			if !ok || approvedAt.Before(start) || approvedAt.After(end) {
+				continue
+			}
+			all = append(all, ApprovedMergeRequest{MergeRequest: mr, ApprovedAt: approvedAt})
+		}
+	}
+
+	return all, nil
+}
+
+// approvalTime reports whether the user approved the MR and when. The approvals
+// endpoint only dates approvals on recent GitLab versions; otherwise the time comes
+// from the system note GitLab adds on approval, and lastly from the MR's last update.
+func (c *Client) approvalTime(mr MergeRequest) (time.Time, bool, error) {
+	path := fmt.Sprintf("/projects/%d/merge_requests/%d", mr.ProjectID, mr.IID)
+
+	var state approvals
+	if err := c.getJSON(path+"/approvals", &state); err != nil {
+		return time.Time{}, false, err
+	}
+
+	approved := false
+	for _, approval := range state.ApprovedBy {
+		if !strings.EqualFold(approval.User.Username, c.username) {
+			continue
+		}
+		if approval.ApprovedAt != nil {
+			return *approval.ApprovedAt, true, nil
+		}
+		approved = true
+	}
+	if !approved {
+		return time.Time{}, false, nil
+	}
+
+	notes, err := getAll[Note](c, path+"/notes", nil)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+	var approvedAt time.Time
+	for _, note := range notes {
+		if note.System && strings.EqualFold(note.Author.Username, c.username) &&
+			strings.HasPrefix(note.Body, "approved this merge request") && note.CreatedAt.After(approvedAt) {
+			approvedAt = note.CreatedAt
+		}
+	}
+	if approvedAt.IsZero() {
+		approvedAt = mr.UpdatedAt
+	}
+
+	return approvedAt, true, nil
+}
+
+// FetchIssues fetches issues authored by or assigned to the user and created in the date range.
+func (c *Client) FetchIssues(start, end time.Time) ([]Issue, error) {
+	var all []Issue
+	seen := make(map[int]bool)
+
+	for _, filter := range []string{"author_username", "assignee_username"} {
+		q := url.Values{}
+		q.Set(filter, c.username)
+		q.Set("created_after", start.Format(time.RFC3339))
+		q.Set("created_before", end.Format(time.RFC3339))
+
+		for _, path := range c.scopedPaths("issues") {
+			issues, err := getAll[Issue](c, path, q)
+			if err != nil {
+				return nil, err
+			}
+			for _, issue := range issues {
+				if seen[issue.ID] {
+					continue
+				}
+				seen[issue.ID] = true
+				all = append(all, issue)
+			}
+		}
+	}
+
+	return all, nil
+}
+
+// scopedPaths returns the API paths for a resource across the configured groups and projects,
+// falling back to the instance-wide endpoint when neither is set.
+func (c *Client) scopedPaths(resource string) []string {
+	var paths []string
+	for _, g := range c.groups {
+		paths = append(paths, fmt.Sprintf("/groups/%s/%s", url.PathEscape(g), resource))
+	}
+	for _, p := range c.projects {
+		paths = append(paths, fmt.Sprintf("/projects/%s/%s", url.PathEscape(p), resource))
+	}
+	if len(paths) == 0 {
+		paths = append(paths, "/"+resource)
+	}
+	return paths
+}
+
+// getAll follows X-Next-Page pagination and collects every page of a list endpoint.
+func getAll[T any](c *Client, path string, query url.Values) ([]T, error) {
+	var all []T
+	page := "1"
+
+	for page != "" {
+		q := url.Values{}
+		for k, v := range query {
+			q[k] = v
+		}
+		q.Set("scope", "all")
+		q.Set("per_page", "100")
+		q.Set("page", page)
+
+		req, err := c.newRequest(path, q)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := c.doWithRetry(req)
+		if err != nil {
+			return nil, fmt.Errorf("request failed after retries: %w", err)
+		}
+
+		if resp.StatusCode != http.StatusOK {
+			body, _ := io.ReadAll(resp.Body)
+			resp.Body.Close()
+			return nil, fmt.Errorf("API error %d on %s: %s", resp.StatusCode, path, string(body))
+		}
+
+		var items []T
+		err = json.NewDecoder(resp.Body).Decode(&items)
+		resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode response: %w", err)
+		}
+
+		all = append(all, items...)
+		page = resp.Header.Get("X-Next-Page")
+	}
+
+	return all, nil
+}
+
+// getJSON decodes a single object from an endpoint.
+func (c *Client) getJSON(path string, out any) error {
+	req, err := c.newRequest(path, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.doWithRetry(req)
+	if err != nil {
+		return fmt.Errorf("request failed after retries: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("API error %d on %s: %s", resp.StatusCode, path, string(body))
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	return nil
+}
+
+func (c *Client) newRequest(path string, query url.Values) (*http.Request, error) {
+	u := c.baseURL + path
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+
+	req, err := http.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("PRIVATE-TOKEN", c.token)
+	req.Header.Set("Accept", "application/json")
+	return req, nil
+}
+
+// doWithRetry performs request with exponential backoff on 429/5xx
+func (c *Client) doWithRetry(req *http.Request) (*http.Response, error) {
+	var resp *http.Response
+	var err error
+
+	for attempt := 0; attempt <= retryMax; attempt++ {
+		if attempt > 0 {
+			backoff := time.Duration(math.Pow(2, float64(attempt))) * time.Second
+			time.Sleep(backoff)
+		}
+
+		if err := c.limiter.Wait(context.Background()); err != nil {
+			return nil, err
+		}
+
+		resp, err = c.httpClient.Do(req)
+		if err != nil {
+			continue
+		}
+
+		if resp.StatusCode == 429 || resp.StatusCode >= 500 {
+			resp.Body.Close()
+			continue
+		}
+
+		return resp, nil
+	}
+
+	return nil, fmt.Errorf("exhausted retries: last error: %v", err)
+}
@@ -0,0 +1,207 @@
+package gitlab
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Afrawles/devreport/internal/llm"
+	"github.com/Afrawles/devreport/internal/report"
+)
+
+type GitLabSource struct {
+	Client *Client
+	// Rephraser rewrites merge requests and issues as achievements; nil keeps them as written.
+	Rephraser llm.Rephraser
+}
+
+func NewGitLabSource(baseURL, token string, groups, projects []string, username string) *GitLabSource {
+	return &GitLabSource{
+		Client: NewClient(baseURL, token, groups, projects, username),
+	}
+}
+
+var _ report.ActivitySource = (*GitLabSource)(nil)
+
+func (g *GitLabSource) Name() string {
+	return "GitLab"
+}
+
+func (g *GitLabSource) HealthCheck() error {
+	return g.Client.HealthCheck()
+}
+
+func (g *GitLabSource) FetchTasks(user string, start, end time.Time) ([]report.Task, error) {
+	var allTasks []report.Task
+
+	mrs, err := g.Client.FetchMergeRequests(start, end)
+	if err != nil {
+		fmt.Printf("Error fetching merge requests: %v\n", err)
+	} else {
+		for _, mr := range mrs {
+			// only a merge completes an MR; closed-unmerged MRs were abandoned
+			completedAt := mr.MergedAt
+
+			body := cleanActivityText(mr.Description)
+
+			var metrics *report.CodeMetrics
+			if diffs, commits, err := g.Client.FetchMergeRequestChanges(mr); err != nil {
+				fmt.Printf("Warning: could not fetch changes for MR %s: %v\n", mr.WebURL, err)
+			} else {
+				metrics = mrMetrics(diffs, commits)
+			}
+
+			task := report.Task{
+				ID:           fmt.Sprintf("%d", mr.IID),
+				Title:        mr.Title,
+				Description:  body,
+				Achievements: g.rephraseMergeRequest(mr.Title, body),
+				Status:       mrStatus(mr),
+				URL:          mr.WebURL,
+				CreatedAt:    mr.CreatedAt,
+				UpdatedAt:    mr.UpdatedAt,
+				CompletedAt:  completedAt,
+				Source:       extractProjectName(mr.WebURL),
+				Type:         "Merge Request",
+				Labels:       mr.Labels,
+				Assignee:     g.Client.username,
+				Metrics:      metrics,
+			}
+			task.StatusCategory = mrCategory(mr)
+			allTasks = append(allTasks, task)
+		}
+	}
+
+	issues, err := g.Client.FetchIssues(start, end)
+	if err != nil {
+		fmt.Printf("Error fetching issues: %v\n", err)
+	} else {
+		for _, issue := range issues {
+			body := cleanActivityText(issue.Description)
+
+			task := report.Task{
+				ID:           fmt.Sprintf("%d", issue.IID),
+				Title:        issue.Title,
+				Description:  body,
+				Achievements: g.rephraseIssue(issue.Title),
+				Status:       issue.State,
+				URL:          issue.WebURL,
+				CreatedAt:    issue.CreatedAt,
+				UpdatedAt:    issue.UpdatedAt,
+				CompletedAt:  issue.ClosedAt,
+				Source:       extractProjectName(issue.WebURL),
+				Type:         "Issue",
+				Labels:       issue.Labels,
+				Assignee:     g.Client.username,
+			}
+			task.StatusCategory = issueCategory(issue)
+			allTasks = append(allTasks, task)
+		}
+	}
+
+	approved, err := g.Client.FetchApprovedMergeRequests(start, end)
+	if err != nil {
+		fmt.Printf("Error fetching approved merge requests: %v\n", err)
+	} else {
+		for _, mr := range approved {
+			approvedAt := mr.ApprovedAt
+
+			task := report.Task{
+				ID:            fmt.Sprintf("%d", mr.IID),
+				Title:         mr.Title,
+				Achievements:  fmt.Sprintf("Reviewed and approved merge request !%d \"%s\"", mr.IID, mr.Title),
+				Status:        "approved",
+				URL:           mr.WebURL,
+				CreatedAt:     approvedAt,
+				UpdatedAt:     mr.UpdatedAt,
+				CompletedAt:   &approvedAt,
+				Source:        extractProjectName(mr.WebURL),
+				Type:          "Review",
+				Labels:        mr.Labels,
+				Assignee:      g.Client.username,
+				ReviewCount:   1,
+				ReviewVerdict: "approved",
+			}
+			allTasks = append(allTasks, task)
+		}
+	}
+
+	return allTasks, nil
+}
+
+// mrStatus maps GitLab MR states onto the report's PR statuses.
+func mrStatus(mr MergeRequest) string {
+	switch mr.State {
+	case "merged":
+		return report.StatusMerged
+	case "closed", "locked":
+		return report.StatusClosed
+	}
+	if mr.Draft {
+		return report.StatusDraft
+	}
+	return report.StatusOpen
+}
+
+// mrCategory maps an MR to a status category the way GitHub PRs are mapped:
+// closed-unmerged MRs were cancelled and open ones await review.
+func mrCategory(mr MergeRequest) string {
+	switch mrStatus(mr) {
+	case report.StatusMerged:
+		return report.CategoryDone
+	case report.StatusClosed:
+		return report.CategoryCancelled
+	case report.StatusDraft:
+		return report.CategoryInProgress
+	default:
+		return report.CategoryReview
+	}
+}
+
+// issueCategory maps GitLab issue states (opened, closed) to a status category.
+func issueCategory(issue Issue) string {
+	if issue.State == "closed" {
+		return report.CategoryDone
+	}
+	return report.CategoryTodo
+}
+
+// mrMetrics reports the size of an MR from its file diffs and commits.
+func mrMetrics(diffs []Diff, commits []Commit) *report.CodeMetrics {
+	metrics := &report.CodeMetrics{
+		FilesChanged: len(diffs),
+		Commits:      len(commits),
+	}
+	for _, diff := range diffs {
+		for _, line := range strings.Split(diff.Diff, "\n") {
+			// GitLab diffs start at the first hunk, without file headers
+			switch {
+			case strings.HasPrefix(line, "+"):
+				metrics.Additions++
+			case strings.HasPrefix(line, "-"):
+				metrics.Deletions++
+			}
+		}
+	}
+	return metrics
+}
+
+func cleanActivityText(value string) string {
+	lines := strings.Split(strings.ReplaceAll(value, "\r\n", "\n"), "\n")
+	for i := range lines {
+		lines[i] = strings.TrimRight(lines[i], " \t")
+	}
+	return strings.TrimSpace(strings.Join(lines, "\n"))
+}
+
+// extractProjectName returns the project path segment of a GitLab web URL,
+// e.g. https://gitlab.example.com/group/sub/project/-/merge_requests/1 -> project.
+func extractProjectName(webURL string) string {
+	parts := strings.Split(webURL, "/")
+	for i, part := range parts {
+		if part == "-" && i > 0 {
+			return parts[i-1]
+		}
+	}
+	return "unknown"
+}
@@ -0,0 +1,61 @@
+package gitlab
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Afrawles/devreport/internal/llm"
+)
+
+// rephraseIssue takes an issue title and rephrases it as a professional achievement.
+func (g *GitLabSource) rephraseIssue(title string) string {
+	if g.Rephraser == nil || strings.TrimSpace(title) == "" {
+		return title
+	}
+
+	prompt := llm.Prompt{
+		Subject: "issue title",
+		Label:   "Issue",
+		Length:  "one sentence max",
+	}.Build(title)
+
+	rephrased, err := g.Rephraser.Rephrase(prompt)
+	if err != nil {
+		fmt.Printf("LLM unavailable for issue rephrase: %v\n", err)
+		return title
+	}
+
+	fmt.Printf("Rephrased issue: %s -> %s\n", title, rephrased)
+	return rephrased
+}
+
+// rephraseMergeRequest takes an MR title and description and rephrases it as a professional achievement.
+func (g *GitLabSource) rephraseMergeRequest(title, body string) string {
+	input := title
+	if strings.TrimSpace(body) != "" {
+		input = title + "\n\n" + body
+	}
+
+	if strings.TrimSpace(input) == "" {
+		return input
+	}
+
+	if g.Rephraser == nil {
+		return title
+	}
+
+	prompt := llm.Prompt{
+		Subject: "merge request title and description",
+		Label:   "Merge request",
+		Length:  "one to two sentences max",
+	}.Build(input)
+
+	rephrased, err := g.Rephraser.Rephrase(prompt)
+	if err != nil {
+		fmt.Printf("LLM unavailable for MR rephrase: %v\n", err)
+		return title
+	}
+
+	fmt.Printf("Rephrased MR: %s -> %s\n", title, rephrased)
+	return rephrased
+}
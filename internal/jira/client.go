@@ -0,0 +1,340 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+const (
+	AuthBasic  = "basic"
+	AuthBearer = "bearer"
+
+	pageSize          = 100
+	requestsPerMinute = 300
+	burst             = 10
+	retryMax          = 5
+
+	timeLayout = "2006-01-02T15:04:05.000-0700"
+	dateLayout = "2006-01-02"
+)
+
+var searchFields = []string{
+	"summary",
+	"description",
+	"status",
+	"resolutiondate",
+	"priority",
+	"duedate",
+	"labels",
+	"created",
+	"updated",
+	"project",
+	"assignee",
+	"issuetype",
+}
+
+type Client struct {
+	baseURL    string
+	token      string
+	email      string
+	authMode   string
+	projects   []string
+	assignee   string
+	cloud      bool
+	httpClient *http.Client
+	limiter    *rate.Limiter
+}
+
+// NewClient creates a Jira client. authMode selects between API-token basic auth (email + token,
+// Jira Cloud) and bearer personal access tokens (Jira Server/Data Center); when empty it is
+// inferred from whether an email is given. An empty assignee means the token owner.
+func NewClient(baseURL, token, email, authMode string, projects []string, assignee string) *Client {
+	if authMode == "" {
+		authMode = AuthBearer
+		if email != "" {
+			authMode = AuthBasic
+		}
+	}
+
+	baseURL = strings.TrimSuffix(baseURL, "/")
+
+	return &Client{
+		baseURL:    baseURL,
+		token:      token,
+		email:      email,
+		authMode:   strings.ToLower(authMode),
+		projects:   projects,
+		assignee:   assignee,
+		cloud:      strings.HasSuffix(hostOf(baseURL), ".atlassian.net"),
+		httpClient: &http.Client{Timeout: 30 * time.Second},
+		limiter:    rate.NewLimiter(rate.Every(time.Minute/requestsPerMinute), burst),
+	}
+}
+
+type Issue struct {
+	ID     string      `json:"id"`
+	Key    string      `json:"key"`
+	Fields IssueFields `json:"fields"`
+}
+
+type IssueFields struct {
+	Summary        string     `json:"summary"`
+	Description    string     `json:"description"`
+	Status         Status     `json:"status"`
+	ResolutionDate string     `json:"resolutiondate"`
+	Priority       *NamedItem `json:"priority"`
+	DueDate        string     `json:"duedate"`
+	Labels         []string   `json:"labels"`
+	Created        string     `json:"created"`
+	Updated        string     `json:"updated"`
+	Project        Project    `json:"project"`
+	Assignee       *User      `json:"assignee"`
+	IssueType      NamedItem  `json:"issuetype"`
+}
+
+type Status struct {
+	Name           string         `json:"name"`
+	StatusCategory StatusCategory `json:"statusCategory"`
+}
+
+type StatusCategory struct {
+	Key  string `json:"key"`
+	Name string `json:"name"`
+}
+
+type NamedItem struct {
+	Name string `json:"name"`
+}
+
+type Project struct {
+	Key  string `json:"key"`
+	Name string `json:"name"`
+}
+
+type User struct {
+	DisplayName  string `json:"displayName"`
+	EmailAddress string `json:"emailAddress"`
+}
+
+type searchResponse struct {
+	Issues []Issue `json:"issues"`
+
+	// Jira Server/Data Center offset pagination
+	StartAt    int `json:"startAt"`
+	MaxResults int `json:"maxResults"`
+	Total      int `json:"total"`
+
+	// Jira Cloud token pagination
+	NextPageToken string `json:"nextPageToken"`
+	IsLast        bool   `json:"isLast"`
+}
+
+func (c *Client) HealthCheck() error {
+	req, err := c.newRequest("/rest/api/2/myself", nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("API health check failed with status %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// BrowseURL returns the web URL of an issue.
+func (c *Client) BrowseURL(key string) string {
+	return fmt.Sprintf("%s/browse/%s", c.baseURL, key)
+}
+
+// BuildJQL returns the query used to select the assignee's issues updated in the date range.
+func (c *Client) BuildJQL(start, end time.Time) string {
+	assignee := "currentUser()"
+	if c.assignee != "" {
+		assignee = quoteJQL(c.assignee)
+	}
+
+	clauses := []string{
+		"assignee = " + assignee,
+		fmt.Sprintf("updated >= %s", quoteJQL(start.Format("2006-01-02 15:04"))),
+		fmt.Sprintf("updated <= %s", quoteJQL(end.Format("2006-01-02 15:04"))),
+	}
+
+	if len(c.projects) > 0 {
+		keys := make([]string, len(c.projects))
+		for i, p := range c.projects {
+			keys[i] = quoteJQL(p)
+		}
+		clauses = append(clauses, fmt.Sprintf("project in (%s)", strings.Join(keys, ", ")))
+	}
+
+	return strings.Join(clauses, " AND ") + " ORDER BY updated DESC"
+}
+
+// FetchIssues runs the JQL query and follows pagination until every matching issue is fetched.
+// Jira Cloud uses the token-paginated /search/jql endpoint; Server/Data Center uses offsets.
+func (c *Client) FetchIssues(start, end time.Time) ([]Issue, error) {
+	jql := c.BuildJQL(start, end)
+
+	var all []Issue
+	startAt := 0
+	nextPageToken := ""
+
+	for {
+		q := url.Values{}
+		q.Set("jql", jql)
+		q.Set("fields", strings.Join(searchFields, ","))
+		q.Set("maxResults", fmt.Sprintf("%d", pageSize))
+
+		path := "/rest/api/2/search"
+		if c.cloud {
+			path = "/rest/api/2/search/jql"
+			if nextPageToken != "" {
+				q.Set("nextPageToken", nextPageToken)
+			}
+		} else {
+			q.Set("startAt", fmt.Sprintf("%d", startAt))
+		}
+
+		req, err := c.newRequest(path, q)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := c.doWithRetry(req)
+		if err != nil {
+			return nil, fmt.Errorf("request failed after retries: %w", err)
+		}
+
+		if resp.StatusCode != http.StatusOK {
+			body, _ := io.ReadAll(resp.Body)
+			resp.Body.Close()
+			return nil, fmt.Errorf("API error %d: %s", resp.StatusCode, string(body))
+		}
+
+		var result searchResponse
+		err = json.NewDecoder(resp.Body).Decode(&result)
+		resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode response: %w", err)
+		}
+
+		all = append(all, result.Issues...)
+		fmt.Printf("  Fetched %d Jira issues (total: %d)\n", len(result.Issues), len(all))
+
+		if c.cloud {
+			if result.IsLast || result.NextPageToken == "" {
+				break
+			}
+			nextPageToken = result.NextPageToken
+			continue
+		}
+
+		startAt += len(result.Issues)
+		if len(result.Issues) == 0 || startAt >= result.Total {
+			break
+		}
+	}
+
+	return all, nil
+}
+
+func (c *Client) newRequest(path string, query url.Values) (*http.Request, error) {
+	u := c.baseURL + path
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+
+	req, err := http.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	if c.authMode == AuthBasic {
+		req.SetBasicAuth(c.email, c.token)
+	} else {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+	req.Header.Set("Accept", "application/json")
+	return req, nil
+}
+
+// doWithRetry performs request with exponential backoff on 429/5xx
+func (c *Client) doWithRetry(req *http.Request) (*http.Response, error) {
+	var resp *http.Response
+	var err error
+
+	for attempt := 0; attempt <= retryMax; attempt++ {
+		if attempt > 0 {
+			backoff := time.Duration(math.Pow(2, float64(attempt))) * time.Second
+			time.Sleep(backoff)
+		}
+
+		if err := c.limiter.Wait(context.Background()); err != nil {
+			return nil, err
+		}
+
+		resp, err = c.httpClient.Do(req)
+		if err != nil {
+			continue
+		}
+
+		if resp.StatusCode == 429 || resp.StatusCode >= 500 {
+			resp.Body.Close()
+			continue
+		}
+
+		return resp, nil
+	}
+
+	return nil, fmt.Errorf("exhausted retries: last error: %v", err)
+}
+
+func quoteJQL(value string) string {
+	return `"` + strings.ReplaceAll(value, `"`, `\"`) + `"`
+}
+
+func hostOf(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return ""
+	}
+	return u.Hostname()
+}
+
+func parseTime(value string) (time.Time, bool) {
+	if value == "" {
+		return time.Time{}, false
+	}
+	t, err := time.Parse(timeLayout, value)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return t, true
+}
+
+func parseDate(value string) (time.Time, bool) {
+	if value == "" {
+		return time.Time{}, false
+	}
+	t, err := time.Parse(dateLayout, value)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return t, true
+}
@@ -0,0 +1,129 @@
+package jira
+
+import (
+	"strings"
+	"time"
+
+	"github.com/Afrawles/devreport/internal/llm"
+	"github.com/Afrawles/devreport/internal/report"
+)
+
+type JiraSource struct {
+	Client *Client
+	// Rephraser rewrites issues as achievements; nil keeps them as written.
+	Rephraser llm.Rephraser
+}
+
+func NewJiraSource(baseURL, token, email, authMode string, projects []string, assignee string) *JiraSource {
+	return &JiraSource{
+		Client: NewClient(baseURL, token, email, authMode, projects, assignee),
+	}
+}
+
+var _ report.ActivitySource = (*JiraSource)(nil)
+
+func (j *JiraSource) Name() string {
+	return "Jira"
+}
+
+func (j *JiraSource) HealthCheck() error {
+	return j.Client.HealthCheck()
+}
+
+func (j *JiraSource) FetchTasks(user string, start, end time.Time) ([]report.Task, error) {
+	issues, err := j.Client.FetchIssues(start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	var allTasks []report.Task
+
+	for _, issue := range issues {
+		f := issue.Fields
+
+		createdAt, _ := parseTime(f.Created)
+		updatedAt, _ := parseTime(f.Updated)
+
+		var completedAt *time.Time
+		if resolved, ok := parseTime(f.ResolutionDate); ok {
+			completedAt = &resolved
+		}
+
+		var dueDate *time.Time
+		if due, ok := parseDate(f.DueDate); ok {
+			dueDate = &due
+		}
+
+		priority := ""
+		if f.Priority != nil {
+			priority = f.Priority.Name
+		}
+
+		assignee := ""
+		if f.Assignee != nil {
+			assignee = f.Assignee.DisplayName
+		}
+
+		status := f.Status.StatusCategory.Name
+		if status == "" {
+			status = f.Status.Name
+		}
+
+		taskType := f.IssueType.Name
+		if taskType == "" {
+			taskType = "Issue"
+		}
+
+		projectName := f.Project.Name
+		if projectName == "" {
+			projectName = f.Project.Key
+		}
+
+		description := strings.TrimSpace(f.Description)
+
+		task := report.Task{
+			ID:           issue.Key,
+			Title:        f.Summary,
+			Description:  description,
+			Achievements: j.rephraseIssue(f.Summary, description),
+			Status:       status,
+			URL:          j.Client.BrowseURL(issue.Key),
+			CreatedAt:    createdAt,
+			UpdatedAt:    updatedAt,
+			CompletedAt:  completedAt,
+			DueDate:      dueDate,
+			Priority:     priority,
+			Source:       projectName,
+			Type:         taskType,
+			Labels:       f.Labels,
+			Assignee:     assignee,
+		}
+		task.StatusCategory = statusCategory(f.Status, completedAt != nil)
+
+		allTasks = append(allTasks, task)
+	}
+
+	return allTasks, nil
+}
+
+// statusCategory maps Jira's status category (new, indeterminate, done) to a
+// report category. The status name tells review and blocked statuses apart
+// from other work in progress, and won't-do resolutions from done ones.
+func statusCategory(status Status, resolved bool) string {
+	switch status.StatusCategory.Key {
+	case "new":
+		return report.CategoryTodo
+	case "indeterminate":
+		switch guess := report.GuessCategory(status.Name, false); guess {
+		case report.CategoryReview, report.CategoryBlocked:
+			return guess
+		}
+		return report.CategoryInProgress
+	case "done":
+		if report.GuessCategory(status.Name, true) == report.CategoryCancelled {
+			return report.CategoryCancelled
+		}
+		return report.CategoryDone
+	}
+	return report.GuessCategory(status.Name, resolved)
+}
@@ -0,0 +1,400 @@
+package linear
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"strings"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+const (
+	apiURL = "https://api.linear.app/graphql"
+
+	pageSize          = 100
+	requestsPerMinute = 60
+	burst             = 5
+	retryMax          = 5
+)
+
+const issueFields = `
+      id
+      identifier
+      title
+      description
+      url
+      priorityLabel
+      dueDate
+      createdAt
+      updatedAt
+      completedAt
+      canceledAt
+      state { name type }
+      assignee { name email }
+      project { name }
+      team { key name }
+      labels { nodes { name } }`
+
+const issuesQuery = `query Issues($filter: IssueFilter, $first: Int, $after: String) {
+  issues(filter: $filter, first: $first, after: $after) {
+    nodes {` + issueFields + `
+    }
+    pageInfo { hasNextPage endCursor }
+  }
+}`
+
+// completedIssuesQuery also reads each issue's state changes, to tell who completed it.
+const completedIssuesQuery = `query CompletedIssues($filter: IssueFilter, $first: Int, $after: String) {
+  issues(filter: $filter, first: $first, after: $after) {
+    nodes {` + issueFields + `
+      history(first: 100) {
+        nodes { createdAt actor { email } toState { type } }
+      }
+    }
+    pageInfo { hasNextPage endCursor }
+  }
+}`
+
+type Client struct {
+	apiKey     string
+	userEmail  string
+	teams      []string
+	httpClient *http.Client
+	limiter    *rate.Limiter
+}
+
+// NewClient creates a Linear client. An empty userEmail selects issues assigned to the API key owner.
+func NewClient(apiKey, userEmail string, teams []string) *Client {
+	return &Client{
+		apiKey:     apiKey,
+		userEmail:  userEmail,
+		teams:      teams,
+		httpClient: &http.Client{Timeout: 30 * time.Second},
+		limiter:    rate.NewLimiter(rate.Every(time.Minute/requestsPerMinute), burst),
+	}
+}
+
+type Issue struct {
+	ID            string     `json:"id"`
+	Identifier    string     `json:"identifier"`
+	Title         string     `json:"title"`
+	Description   string     `json:"description"`
+	URL           string     `json:"url"`
+	PriorityLabel string     `json:"priorityLabel"`
+	DueDate       string     `json:"dueDate"`
+	CreatedAt     time.Time  `json:"createdAt"`
+	UpdatedAt     time.Time  `json:"updatedAt"`
+	CompletedAt   *time.Time `json:"completedAt"`
+	CanceledAt    *time.Time `json:"canceledAt"`
+	State         State      `json:"state"`
+	Assignee      *User      `json:"assignee"`
+	Project       *Named     `json:"project"`
+	Team          Team       `json:"team"`
+	Labels        struct {
+		Nodes []Named `json:"nodes"`
+	} `json:"labels"`
+}
+
+// completedIssue is an issue with its state changes.
+type completedIssue struct {
+	Issue
+	History struct {
+		Nodes []historyEntry `json:"nodes"`
+	} `json:"history"`
+}
+
+type historyEntry struct {
+	CreatedAt time.Time `json:"createdAt"`
+	Actor     *User     `json:"actor"`
+	ToState   *State    `json:"toState"`
+}
+
+type State struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+type User struct {
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
+
+type Named struct {
+	Name string `json:"name"`
+}
+
+type Team struct {
+	Key  string `json:"key"`
+	Name string `json:"name"`
+}
+
+type pageInfo struct {
+	HasNextPage bool   `json:"hasNextPage"`
+	EndCursor   string `json:"endCursor"`
+}
+
+type graphQLRequest struct {
+	Query     string         `json:"query"`
+	Variables map[string]any `json:"variables,omitempty"`
+}
+
+type graphQLError struct {
+	Message string `json:"message"`
+}
+
+type issuesResponse[T any] struct {
+	Data struct {
+		Issues struct {
+			Nodes    []T      `json:"nodes"`
+			PageInfo pageInfo `json:"pageInfo"`
+		} `json:"issues"`
+	} `json:"data"`
+	Errors []graphQLError `json:"errors"`
+}
+
+func (c *Client) HealthCheck() error {
+	var result struct {
+		Data struct {
+			Viewer struct {
+				ID string `json:"id"`
+			} `json:"viewer"`
+		} `json:"data"`
+		Errors []graphQLError `json:"errors"`
+	}
+
+	if err := c.query(graphQLRequest{Query: `query { viewer { id } }`}, &result); err != nil {
+		return err
+	}
+	if len(result.Errors) > 0 {
+		return fmt.Errorf("API health check failed: %s", result.Errors[0].Message)
+	}
+
+	return nil
+}
+
+// FetchIssues fetches issues assigned to the user that were updated or completed in the date range,
+// and issues the user completed in the range while they were assigned to someone else.
+func (c *Client) FetchIssues(start, end time.Time) ([]Issue, error) {
+	all, err := fetchIssues[Issue](c, issuesQuery, c.buildFilter(start, end))
+	if err != nil {
+		return nil, err
+	}
+
+	completed, err := c.fetchCompletedBy(start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]bool, len(all))
+	for _, issue := range all {
+		seen[issue.ID] = true
+	}
+	for _, issue := range completed {
+		if !seen[issue.ID] {
+			seen[issue.ID] = true
+			all = append(all, issue)
+		}
+	}
+
+	return all, nil
+}
+
+// fetchCompletedBy returns the issues completed in the date range that the user
+// moved to a completed state. The issue filter has no "completed by" field, so
+// issues completed in the range are read with their history and matched on the
+// actor of the completing state change.
+func (c *Client) fetchCompletedBy(start, end time.Time) ([]Issue, error) {
+	email := c.userEmail
+	if email == "" {
+		var err error
+		if email, err = c.viewerEmail(); err != nil {
+			return nil, err
+		}
+	}
+
+	filter := map[string]any{
+		"completedAt": map[string]any{
+			"gte": start.Format(time.RFC3339),
+			"lte": end.Format(time.RFC3339),
+		},
+	}
+	if len(c.teams) > 0 {
+		filter["team"] = map[string]any{"key": map[string]any{"in": c.teams}}
+	}
+
+	candidates, err := fetchIssues[completedIssue](c, completedIssuesQuery, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	var issues []Issue
+	for _, candidate := range candidates {
+		for _, entry := range candidate.History.Nodes {
+			if entry.ToState == nil || entry.ToState.Type != "completed" || entry.Actor == nil {
+				continue
+			}
+			if entry.CreatedAt.Before(start) || entry.CreatedAt.After(end) {
+				continue
+			}
+			if strings.EqualFold(entry.Actor.Email, email) {
+				issues = append(issues, candidate.Issue)
+				break
+			}
+		}
+	}
+
+	return issues, nil
+}
+
+// viewerEmail returns the email of the API key owner.
+func (c *Client) viewerEmail() (string, error) {
+	var result struct {
+		Data struct {
+			Viewer struct {
+				Email string `json:"email"`
+			} `json:"viewer"`
+		} `json:"data"`
+		Errors []graphQLError `json:"errors"`
+	}
+
+	if err := c.query(graphQLRequest{Query: `query { viewer { email } }`}, &result); err != nil {
+		return "", err
+	}
+	if len(result.Errors) > 0 {
+		return "", fmt.Errorf("GraphQL error: %s", result.Errors[0].Message)
+	}
+
+	return result.Data.Viewer.Email, nil
+}
+
+// fetchIssues runs an issues query, following cursor pagination until every
+// page has been read.
+func fetchIssues[T any](c *Client, query string, filter map[string]any) ([]T, error) {
+	var all []T
+	var after *string
+
+	for {
+		if err := c.limiter.Wait(context.Background()); err != nil {
+			return nil, err
+		}
+
+		var result issuesResponse[T]
+		err := c.query(graphQLRequest{
+			Query: query,
+			Variables: map[string]any{
+				"filter": filter,
+				"first":  pageSize,
+				"after":  after,
+			},
+		}, &result)
+		if err != nil {
+			return nil, err
+		}
+		if len(result.Errors) > 0 {
+			return nil, fmt.Errorf("GraphQL error: %s", result.Errors[0].Message)
+		}
+
+		issues := result.Data.Issues
+		all = append(all, issues.Nodes...)
+		fmt.Printf("  Fetched %d Linear issues (total: %d)\n", len(issues.Nodes), len(all))
+
+		if !issues.PageInfo.HasNextPage || issues.PageInfo.EndCursor == "" {
+			break
+		}
+		cursor := issues.PageInfo.EndCursor
+		after = &cursor
+	}
+
+	return all, nil
+}
+
+// buildFilter selects the user's issues by assignee.
+func (c *Client) buildFilter(start, end time.Time) map[string]any {
+	window := map[string]any{
+		"gte": start.Format(time.RFC3339),
+		"lte": end.Format(time.RFC3339),
+	}
+
+	assignee := map[string]any{"isMe": map[string]any{"eq": true}}
+	if c.userEmail != "" {
+		assignee = map[string]any{"email": map[string]any{"eqIgnoreCase": c.userEmail}}
+	}
+
+	filter := map[string]any{
+		"assignee": assignee,
+		"or": []map[string]any{
+			{"updatedAt": window},
+			{"completedAt": window},
+		},
+	}
+
+	if len(c.teams) > 0 {
+		filter["team"] = map[string]any{"key": map[string]any{"in": c.teams}}
+	}
+
+	return filter
+}
+
+func (c *Client) query(body graphQLRequest, out any) error {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	resp, err := c.doWithRetry(payload)
+	if err != nil {
+		return fmt.Errorf("request failed after retries: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		data, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("API error %d: %s", resp.StatusCode, string(data))
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return nil
+}
+
+// doWithRetry performs request with exponential backoff on 429/5xx
+func (c *Client) doWithRetry(payload []byte) (*http.Response, error) {
+	var resp *http.Response
+	var err error
+
+	for attempt := 0; attempt <= retryMax; attempt++ {
+		if attempt > 0 {
+			backoff := time.Duration(math.Pow(2, float64(attempt))) * time.Second
+			time.Sleep(backoff)
+		}
+
+		req, reqErr := http.NewRequest("POST", apiURL, bytes.NewReader(payload))
+		if reqErr != nil {
+			return nil, fmt.Errorf("failed to create request: %w", reqErr)
+		}
+		req.Header.Set("Authorization", c.apiKey)
+		req.Header.Set("Content-Type", "application/json")
+
+		resp, err = c.httpClient.Do(req)
+		if err != nil {
+			continue
+		}
+
+		if resp.StatusCode == 429 || resp.StatusCode >= 500 {
+			resp.Body.Close()
+			continue
+		}
+
+		return resp, nil
+	}
+
+	return nil, fmt.Errorf("exhausted retries: last error: %v", err)
+}
@@ -0,0 +1,130 @@
+package linear
+
+import (
+	"strings"
+	"time"
+
+	"github.com/Afrawles/devreport/internal/llm"
+	"github.com/Afrawles/devreport/internal/report"
+)
+
+type LinearSource struct {
+	Client *Client
+	// Rephraser rewrites issues as achievements; nil keeps them as written.
+	Rephraser llm.Rephraser
+}
+
+func NewLinearSource(apiKey, userEmail string, teams []string) *LinearSource {
+	return &LinearSource{
+		Client: NewClient(apiKey, userEmail, teams),
+	}
+}
+
+var _ report.ActivitySource = (*LinearSource)(nil)
+
+func (l *LinearSource) Name() string {
+	return "Linear"
+}
+
+func (l *LinearSource) HealthCheck() error {
+	return l.Client.HealthCheck()
+}
+
+func (l *LinearSource) FetchTasks(user string, start, end time.Time) ([]report.Task, error) {
+	issues, err := l.Client.FetchIssues(start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	var allTasks []report.Task
+
+	for _, issue := range issues {
+		var dueDate *time.Time
+		if issue.DueDate != "" {
+			if due, err := time.Parse("2006-01-02", issue.DueDate); err == nil {
+				dueDate = &due
+			}
+		}
+
+		assignee := ""
+		if issue.Assignee != nil {
+			assignee = issue.Assignee.Name
+		}
+
+		projectName := issue.Team.Name
+		if issue.Project != nil && issue.Project.Name != "" {
+			projectName = issue.Project.Name
+		}
+
+		var labels []string
+		for _, label := range issue.Labels.Nodes {
+			labels = append(labels, label.Name)
+		}
+
+		description := strings.TrimSpace(issue.Description)
+
+		task := report.Task{
+			ID:           issue.Identifier,
+			Title:        issue.Title,
+			Description:  description,
+			Achievements: l.rephraseIssue(issue.Title, description),
+			Status:       mapStateType(issue.State),
+			URL:          issue.URL,
+			CreatedAt:    issue.CreatedAt,
+			UpdatedAt:    issue.UpdatedAt,
+			CompletedAt:  issue.CompletedAt,
+			DueDate:      dueDate,
+			Priority:     issue.PriorityLabel,
+			Source:       projectName,
+			Type:         "Issue",
+			Labels:       labels,
+			Assignee:     assignee,
+		}
+		task.StatusCategory = stateCategory(issue.State)
+
+		allTasks = append(allTasks, task)
+	}
+
+	return allTasks, nil
+}
+
+// mapStateType converts a Linear workflow state type into a report status,
+// keeping the team's own state name for types Linear does not define.
+func mapStateType(state State) string {
+	switch state.Type {
+	case "triage":
+		return "triage"
+	case "backlog":
+		return "backlog"
+	case "unstarted":
+		return "todo"
+	case "started":
+		return "in progress"
+	case "completed":
+		return "complete"
+	case "canceled":
+		return "cancelled"
+	default:
+		return strings.ToLower(state.Name)
+	}
+}
+
+// stateCategory maps a Linear workflow state type to a report category. The
+// state name tells review and blocked states apart from other started work.
+func stateCategory(state State) string {
+	switch state.Type {
+	case "triage", "backlog", "unstarted":
+		return report.CategoryTodo
+	case "started":
+		switch guess := report.GuessCategory(state.Name, false); guess {
+		case report.CategoryReview, report.CategoryBlocked:
+			return guess
+		}
+		return report.CategoryInProgress
+	case "completed":
+		return report.CategoryDone
+	case "canceled":
+		return report.CategoryCancelled
+	}
+	return report.GuessCategory(state.Name, false)
+}
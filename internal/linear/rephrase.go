@@ -0,0 +1,39 @@
+package linear
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Afrawles/devreport/internal/llm"
+)
+
+// rephraseIssue takes a Linear issue summary and description and rephrases it as a professional achievement.
+func (l *LinearSource) rephraseIssue(summary, description string) string {
+	input := summary
+	if strings.TrimSpace(description) != "" {
+		input = summary + "\n\n" + description
+	}
+
+	if strings.TrimSpace(input) == "" {
+		return input
+	}
+
+	if l.Rephraser == nil {
+		return summary
+	}
+
+	prompt := llm.Prompt{
+		Subject: "issue summary and description",
+		Label:   "Issue",
+		Length:  "one to two sentences max",
+	}.Build(input)
+
+	rephrased, err := l.Rephraser.Rephrase(prompt)
+	if err != nil {
+		fmt.Printf("LLM unavailable for issue rephrase: %v\n", err)
+		return summary
+	}
+
+	fmt.Printf("Rephrased issue: %s -> %s\n", summary, rephrased)
+	return rephrased
+}
@@ -0,0 +1,60 @@
+// Package llm talks to the language model that rephrases activities into
+// achievement bullet points.
+package llm
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Providers accepted by New.
+const (
+	ProviderOllama = "ollama"
+	ProviderOpenAI = "openai"
+	ProviderNone   = "none"
+)
+
+const requestTimeout = 30 * time.Second
+
+// Rephraser sends a prompt to a model and returns its reply.
+type Rephraser interface {
+	Rephrase(prompt string) (string, error)
+}
+
+// Config selects and configures a provider. Empty fields take the provider's defaults.
+type Config struct {
+	Provider string
+	URL      string
+	Model    string
+	// APIKey is sent as a bearer token to OpenAI-compatible servers that need one.
+	APIKey string
+}
+
+// New returns the Rephraser for the configured provider, or nil when
+// rephrasing is turned off.
+func New(cfg Config) (Rephraser, error) {
+	switch strings.ToLower(strings.TrimSpace(cfg.Provider)) {
+	case "", ProviderOllama:
+		return NewOllama(cfg.URL, cfg.Model), nil
+	case ProviderOpenAI:
+		return NewOpenAI(cfg.URL, cfg.Model, cfg.APIKey), nil
+	case ProviderNone:
+		return nil, nil
+	}
+	return nil, fmt.Errorf("unknown LLM provider %q (valid: ollama, openai, none)", cfg.Provider)
+}
+
+func newHTTPClient() *http.Client {
+	return &http.Client{Timeout: requestTimeout}
+}
+
+// reply trims a model reply and rejects empty ones.
+func reply(provider, content string) (string, error) {
+	content = strings.TrimSpace(content)
+	if content == "" {
+		return "", fmt.Errorf("%s returned empty content", provider)
+	}
+	return content, nil
+}
@@ -0,0 +1,82 @@
+package llm
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+const (
+	defaultOllamaURL   = "http://localhost:11434"
+	defaultOllamaModel = "gemma4:e4b"
+)
+
+// Ollama rephrases through the chat API of an Ollama server.
+type Ollama struct {
+	URL    string
+	Model  string
+	client *http.Client
+}
+
+// NewOllama returns an Ollama client. Empty values default to a local
+// server on port 11434 and the gemma4:e4b model.
+func NewOllama(url, model string) *Ollama {
+	if url == "" {
+		url = defaultOllamaURL
+	}
+	if model == "" {
+		model = defaultOllamaModel
+	}
+	return &Ollama{
+		URL:    strings.TrimSuffix(url, "/"),
+		Model:  model,
+		client: newHTTPClient(),
+	}
+}
+
+var _ Rephraser = (*Ollama)(nil)
+
+type message struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+type ollamaChatRequest struct {
+	Model    string    `json:"model"`
+	Messages []message `json:"messages"`
+	Stream   bool      `json:"stream"`
+}
+
+type ollamaChatResponse struct {
+	Message message `json:"message"`
+}
+
+func (o *Ollama) Rephrase(prompt string) (string, error) {
+	reqBody, err := json.Marshal(ollamaChatRequest{
+		Model:    o.Model,
+		Messages: []message{{Role: "user", Content: prompt}},
+		Stream:   false,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	resp, err := o.client.Post(o.URL+"/api/chat", "application/json", bytes.NewBuffer(reqBody))
+	if err != nil {
+		return "", fmt.Errorf("ollama unavailable: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("ollama returned status %d", resp.StatusCode)
+	}
+
+	var parsed ollamaChatResponse
+	if err := json.NewDecoder(resp.Body).Decode(&parsed); err != nil {
+		return "", fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return reply("ollama", parsed.Message.Content)
+}
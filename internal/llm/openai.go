@@ -0,0 +1,92 @@
+package llm
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+const defaultOpenAIURL = "http://localhost:8080/v1"
+
+// OpenAI rephrases through an OpenAI-compatible chat completions API, as
+// served by llama.cpp, vLLM, LM Studio and others.
+type OpenAI struct {
+	// URL is the API base, e.g. http://localhost:8080/v1.
+	URL string
+	// Model may be empty for servers that host a single model.
+	Model  string
+	APIKey string
+	client *http.Client
+}
+
+// NewOpenAI returns an OpenAI-compatible client. An empty URL defaults to a
+// local llama.cpp server.
+func NewOpenAI(url, model, apiKey string) *OpenAI {
+	if url == "" {
+		url = defaultOpenAIURL
+	}
+	return &OpenAI{
+		URL:    strings.TrimSuffix(url, "/"),
+		Model:  model,
+		APIKey: apiKey,
+		client: newHTTPClient(),
+	}
+}
+
+var _ Rephraser = (*OpenAI)(nil)
+
+type openAIChatRequest struct {
+	Model    string    `json:"model,omitempty"`
+	Messages []message `json:"messages"`
+	Stream   bool      `json:"stream"`
+}
+
+type openAIChatResponse struct {
+	Choices []struct {
+		Message message `json:"message"`
+	} `json:"choices"`
+}
+
+func (o *OpenAI) Rephrase(prompt string) (string, error) {
+	reqBody, err := json.Marshal(openAIChatRequest{
+		Model:    o.Model,
+		Messages: []message{{Role: "user", Content: prompt}},
+		Stream:   false,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	req, err := http.NewRequest("POST", o.URL+"/chat/completions", bytes.NewBuffer(reqBody))
+	if err != nil {
+		return "", fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if o.APIKey != "" {
+		req.Header.Set("Authorization", "Bearer "+o.APIKey)
+	}
+
+	resp, err := o.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("LLM server unavailable: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return "", fmt.Errorf("LLM server returned status %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	var parsed openAIChatResponse
+	if err := json.NewDecoder(resp.Body).Decode(&parsed); err != nil {
+		return "", fmt.Errorf("failed to decode response: %w", err)
+	}
+	if len(parsed.Choices) == 0 {
+		return "", fmt.Errorf("LLM server returned no choices")
+	}
+
+	return reply("LLM server", parsed.Choices[0].Message.Content)
+}
@@ -0,0 +1,57 @@
+package llm
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Prompt describes an activity to rephrase as an achievement bullet point.
+type Prompt struct {
+	// Subject names the input in the instruction, e.g. "git commit message".
+	Subject string
+	// Label introduces the input, e.g. "Commit message".
+	Label string
+	// Focus is what the bullet point should focus on; empty means the accomplishment.
+	Focus string
+	// Length caps the reply, e.g. "one sentence max"; empty leaves it open.
+	Length string
+	// Rules replaces the rules shared by code activities, for sources whose
+	// input needs different ones. Focus and Length are then ignored.
+	Rules []string
+}
+
+// Build returns the prompt for input, with numbered rules.
+func (p Prompt) Build(input string) string {
+	rules := p.Rules
+	if len(rules) == 0 {
+		rules = p.sharedRules()
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "Rephrase the following %s as a concise, professional achievement bullet point.\n\n", p.Subject)
+	b.WriteString("STRICT RULES:\n")
+	for i, rule := range rules {
+		fmt.Fprintf(&b, "%d. %s\n", i+1, rule)
+	}
+	fmt.Fprintf(&b, "\n%s:\n%s", p.Label, input)
+	return b.String()
+}
+
+// sharedRules are the rules for commits, pull requests, issues and releases.
+func (p Prompt) sharedRules() []string {
+	focus := p.Focus
+	if focus == "" {
+		focus = "the accomplishment"
+	}
+
+	rules := []string{
+		"Use strong action verbs and focus on " + focus,
+		"PRESERVE all numerical values, version numbers, and identifiers EXACTLY as written",
+		"Only fix spelling errors and grammar mistakes",
+		"Do NOT change the core meaning or technical details",
+	}
+	if p.Length != "" {
+		rules = append(rules, "Keep it concise — "+p.Length)
+	}
+	return append(rules, "Return only the rephrased text without bullet point symbols (•, -, *)")
+}
@@ -0,0 +1,103 @@
+package localgit
+
+import (
+	"fmt"
+	"os/exec"
+	"time"
+
+	"github.com/Afrawles/devreport/internal/llm"
+	"github.com/Afrawles/devreport/internal/report"
+)
+
+type LocalGitSource struct {
+	Paths    []string
+	Authors  []string
+	Branches []string
+	// Rephraser rewrites commit messages as achievements; nil keeps them as written.
+	Rephraser llm.Rephraser
+}
+
+func NewLocalGitSource(paths, authors, branches []string) *LocalGitSource {
+	return &LocalGitSource{
+		Paths:    paths,
+		Authors:  authors,
+		Branches: branches,
+	}
+}
+
+var _ report.ActivitySource = (*LocalGitSource)(nil)
+
+func (l *LocalGitSource) Name() string {
+	return "Local Git"
+}
+
+func (l *LocalGitSource) HealthCheck() error {
+	if _, err := exec.LookPath("git"); err != nil {
+		return fmt.Errorf("git executable not found: %w", err)
+	}
+	if len(l.Paths) == 0 {
+		return fmt.Errorf("no repository paths configured")
+	}
+	return nil
+}
+
+func (l *LocalGitSource) FetchTasks(user string, start, end time.Time) ([]report.Task, error) {
+	repos, err := DiscoverRepos(l.Paths)
+	if err != nil {
+		return nil, err
+	}
+
+	var allTasks []report.Task
+
+	for _, repo := range repos {
+		authors := l.Authors
+		if len(authors) == 0 {
+			if email := repo.ConfiguredEmail(); email != "" {
+				authors = []string{email}
+			} else {
+				fmt.Printf("Warning: no author configured for %s, skipping\n", repo.Name)
+				continue
+			}
+		}
+
+		commits, err := repo.Commits(authors, l.Branches, start, end)
+		if err != nil {
+			fmt.Printf("Warning: could not read commits for %s: %v\n", repo.Name, err)
+			continue
+		}
+
+		fmt.Printf("  %s: %d commits\n", repo.Name, len(commits))
+
+		for _, commit := range commits {
+			completedAt := commit.Date
+
+			achievementInput := commit.Subject
+			if commit.Body != "" {
+				achievementInput = commit.Subject + "\n\n" + commit.Body
+			}
+
+			task := report.Task{
+				ID:           commit.Hash[:min(7, len(commit.Hash))],
+				Title:        commit.Subject,
+				Description:  commit.Body,
+				Achievements: l.rephraseCommit(achievementInput),
+				Status:       "committed",
+				CreatedAt:    commit.Date,
+				UpdatedAt:    commit.Date,
+				CompletedAt:  &completedAt,
+				Source:       repo.Name,
+				Type:         "Commit",
+				Assignee:     commit.AuthorName,
+				Metrics: &report.CodeMetrics{
+					Additions:    commit.Additions,
+					Deletions:    commit.Deletions,
+					FilesChanged: commit.FilesChanged,
+					Commits:      1,
+				},
+			}
+			allTasks = append(allTasks, task)
+		}
+	}
+
+	return allTasks, nil
+}
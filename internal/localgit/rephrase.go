@@ -0,0 +1,30 @@
+package localgit
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Afrawles/devreport/internal/llm"
+)
+
+// rephraseCommit takes a git commit message and rephrases it as a professional achievement.
+func (l *LocalGitSource) rephraseCommit(message string) string {
+	if l.Rephraser == nil || strings.TrimSpace(message) == "" {
+		return message
+	}
+
+	prompt := llm.Prompt{
+		Subject: "git commit message",
+		Label:   "Commit message",
+		Length:  "one sentence max",
+	}.Build(message)
+
+	rephrased, err := l.Rephraser.Rephrase(prompt)
+	if err != nil {
+		fmt.Printf("LLM unavailable for commit rephrase: %v\n", err)
+		return message
+	}
+
+	fmt.Printf("Rephrased commit: %s -> %s\n", strings.SplitN(message, "\n", 2)[0], rephrased)
+	return rephrased
+}
@@ -0,0 +1,221 @@
+package localgit
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	recordSep = "\x1e"
+	fieldSep  = "\x1f"
+
+	logFormat = recordSep + "%H" + fieldSep + "%an" + fieldSep + "%ae" + fieldSep + "%aI" + fieldSep + "%s" + fieldSep + "%b" + fieldSep
+)
+
+type Commit struct {
+	Repo         string
+	Hash         string
+	AuthorName   string
+	AuthorEmail  string
+	Date         time.Time
+	Subject      string
+	Body         string
+	Additions    int
+	Deletions    int
+	FilesChanged int
+}
+
+type Repo struct {
+	Path string
+	Name string
+}
+
+// DiscoverRepos resolves each path to a git repository, or to the repositories
+// directly beneath it when the path itself is not one.
+func DiscoverRepos(paths []string) ([]Repo, error) {
+	var repos []Repo
+	seen := make(map[string]bool)
+
+	add := func(path string) {
+		abs, err := filepath.Abs(path)
+		if err != nil || seen[abs] {
+			return
+		}
+		seen[abs] = true
+		repos = append(repos, Repo{Path: abs, Name: filepath.Base(abs)})
+	}
+
+	for _, path := range paths {
+		if isRepo(path) {
+			add(path)
+			continue
+		}
+
+		entries, err := os.ReadDir(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", path, err)
+		}
+
+		found := false
+		for _, entry := range entries {
+			child := filepath.Join(path, entry.Name())
+			if entry.IsDir() && isRepo(child) {
+				add(child)
+				found = true
+			}
+		}
+		if !found {
+			fmt.Printf("Warning: no git repositories found in %s\n", path)
+		}
+	}
+
+	sort.Slice(repos, func(i, j int) bool {
+		return repos[i].Name < repos[j].Name
+	})
+
+	return repos, nil
+}
+
+func isRepo(path string) bool {
+	_, err := os.Stat(filepath.Join(path, ".git"))
+	return err == nil
+}
+
+// ConfiguredEmail returns the user.email configured for the repository, if any.
+func (r Repo) ConfiguredEmail() string {
+	out, err := r.git("config", "user.email")
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(out)
+}
+
+// Commits lists non-merge commits by any of the authors authored within the date range.
+// With no branches every local and remote-tracking branch and tag is searched, but
+// not stashes or notes; branch entries may be names or glob patterns such as
+// release/*. Named branches missing from the repository are skipped.
+func (r Repo) Commits(authors, branches []string, start, end time.Time) ([]Commit, error) {
+	// the range applies to author dates, as reported; git filters on committer
+	// dates, which are normally no earlier, so only the start bound is passed on
+	args := []string{
+		"log",
+		"--no-merges",
+		"--date-order",
+		"--numstat",
+		"--fixed-strings",
+		"--format=" + logFormat,
+		"--since=" + start.Format(time.RFC3339),
+	}
+	for _, author := range authors {
+		args = append(args, "--author="+author)
+	}
+
+	if len(branches) == 0 {
+		args = append(args, "--branches", "--remotes", "--tags")
+	} else {
+		revs := 0
+		for _, branch := range branches {
+			if strings.ContainsAny(branch, "*?[") {
+				args = append(args, "--branches="+branch)
+				revs++
+			} else if r.hasRef(branch) {
+				args = append(args, branch)
+				revs++
+			} else {
+				fmt.Printf("Warning: branch %s not found in %s, skipping it\n", branch, r.Name)
+			}
+		}
+		// git log with no revisions would fall back to HEAD
+		if revs == 0 {
+			return nil, nil
+		}
+	}
+	args = append(args, "--")
+
+	out, err := r.git(args...)
+	if err != nil {
+		return nil, err
+	}
+
+	var commits []Commit
+	for _, commit := range parseLog(r.Name, out) {
+		if !commit.Date.Before(start) && !commit.Date.After(end) {
+			commits = append(commits, commit)
+		}
+	}
+	return commits, nil
+}
+
+// hasRef reports whether ref names a commit in the repository.
+func (r Repo) hasRef(ref string) bool {
+	_, err := r.git("rev-parse", "--verify", "--quiet", ref+"^{commit}")
+	return err == nil
+}
+
+func (r Repo) git(args ...string) (string, error) {
+	cmd := exec.Command("git", append([]string{"-C", r.Path}, args...)...)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("git %s: %w: %s", args[0], err, strings.TrimSpace(stderr.String()))
+	}
+	return stdout.String(), nil
+}
+
+func parseLog(repo, out string) []Commit {
+	var commits []Commit
+
+	for _, record := range strings.Split(out, recordSep) {
+		if strings.TrimSpace(record) == "" {
+			continue
+		}
+
+		fields := strings.SplitN(record, fieldSep, 7)
+		if len(fields) < 7 {
+			continue
+		}
+
+		date, err := time.Parse(time.RFC3339, fields[3])
+		if err != nil {
+			continue
+		}
+
+		commit := Commit{
+			Repo:        repo,
+			Hash:        fields[0],
+			AuthorName:  fields[1],
+			AuthorEmail: fields[2],
+			Date:        date,
+			Subject:     strings.TrimSpace(fields[4]),
+			Body:        strings.TrimSpace(fields[5]),
+		}
+
+		for _, line := range strings.Split(fields[6], "\n") {
+			parts := strings.Split(line, "\t")
+			if len(parts) < 3 {
+				continue
+			}
+			commit.FilesChanged++
+			// binary files report "-" for both counts
+			if added, err := strconv.Atoi(parts[0]); err == nil {
+				commit.Additions += added
+			}
+			if deleted, err := strconv.Atoi(parts[1]); err == nil {
+				commit.Deletions += deleted
+			}
+		}
+
+		commits = append(commits, commit)
+	}
+
+	return commits
+}
@@ -12,6 +12,9 @@ import (
 
 type CSVExporter struct {
 	OutputDir string
+	// DateField decides which tasks the dashboard counts as reported in the
+	// period rather than older.
+	DateField DateField
 }
 
 func NewCSVExporter(outputDir string) *CSVExporter {
@@ -56,6 +59,7 @@ func (e *CSVExporter) exportTaskList(tasks []Task, timestamp string, start, end
 		"Due Date",
 		"Priority",
 		"Date Cleared",
+		"Time Spent",
 		"Project Name",
 		"Challenges",
 		"Support Required",
@@ -66,21 +70,23 @@ func (e *CSVExporter) exportTaskList(tasks []Task, timestamp string, start, end
 		return err
 	}
 
-	for i, task := range tasks {
+	for _, entry := range numberTasks(tasks) {
+		task := entry.task
 		projectName := task.Source
 		if projectName == "" || projectName == "ClickUp" {
 			projectName = extractProjectName(task.Title)
 		}
 
 		row := []string{
-			fmt.Sprintf("%d", i+1),
-			task.Title,
+			entry.number,
+			strings.Repeat("  ", entry.depth) + task.Title,
 			task.Assignee,
 			normalizeStatus(task.Status),
 			formatDate(task.CreatedAt),
-			"",
-			"",
+			formatDatePtr(task.DueDate),
+			task.Priority,
 			formatDatePtr(task.CompletedAt),
+			formatDuration(task.TimeSpent),
 			projectName,
 			task.Challenges,
 			task.SupportRequired,
@@ -106,33 +112,18 @@ func (e *CSVExporter) exportDashboard(tasks []Task, timestamp string, start, end
 	writer := csv.NewWriter(file)
 	defer writer.Flush()
 
-	statusOrder := []string{
-		"in progress",
-		"issues",
-		"improvements",
-		"ready for qa",
-		"ready for deployment",
-		"failed qa",
-		"backlog",
-		"current sprint",
-		"ready",
-		"todo",
-		"new development",
-		"on hold",
-		"urgent support",
-		"suspended",
-	}
-
 	type ProjectStats struct {
-		older    map[string]int
-		thisWeek map[string]int
-		all      map[string]int
+		older    map[statusKey]int
+		thisWeek map[statusKey]int
+		all      map[statusKey]int
 	}
 
 	projectData := make(map[string]*ProjectStats)
 	projectNames := []string{}
 	projectNameSet := make(map[string]bool)
 
+	// subtasks have statuses of their own and are counted like any other task
+	tasks = flattenSubtasks(tasks)
 	for _, task := range tasks {
 		project := task.Source
 		if project == "" || project == "ClickUp" {
@@ -146,15 +137,15 @@ func (e *CSVExporter) exportDashboard(tasks []Task, timestamp string, start, end
 
 		if projectData[project] == nil {
 			projectData[project] = &ProjectStats{
-				older:    make(map[string]int),
-				thisWeek: make(map[string]int),
-				all:      make(map[string]int),
+				older:    make(map[statusKey]int),
+				thisWeek: make(map[statusKey]int),
+				all:      make(map[statusKey]int),
 			}
 		}
 
-		status := strings.ToLower(strings.TrimSpace(task.Status))
+		status := statusOf(task)
 
-		isThisWeek := task.CreatedAt.After(start) || task.CreatedAt.Equal(start)
+		isThisWeek := task.InPeriod(e.DateField, start, time.Time{})
 
 		if isThisWeek {
 			projectData[project].thisWeek[status]++
@@ -194,30 +185,55 @@ func (e *CSVExporter) exportDashboard(tasks []Task, timestamp string, start, end
 		return err
 	}
 
-	projectTotals := make(map[string]struct{ older, thisWeek, all int })
-	for _, status := range statusOrder {
-		row := []string{"", normalizeStatusDisplay(status)}
+	type counts struct{ older, thisWeek, all int }
+
+	// every status found is listed under its category, followed by the category subtotal
+	projectTotals := make(map[string]counts)
+	for _, group := range groupStatuses(tasks) {
+		subtotals := make(map[string]counts)
+		for _, status := range group.statuses {
+			row := []string{"", normalizeStatusDisplay(status.status)}
+
+			for _, project := range projectNames {
+				stats := projectData[project]
+				older := stats.older[status]
+				thisWeek := stats.thisWeek[status]
+				all := stats.all[status]
+
+				row = append(row,
+					fmt.Sprintf("%d", older),
+					fmt.Sprintf("%d", thisWeek),
+					fmt.Sprintf("%d", all),
+				)
+
+				subtotal := subtotals[project]
+				subtotal.older += older
+				subtotal.thisWeek += thisWeek
+				subtotal.all += all
+				subtotals[project] = subtotal
+			}
 
+			if err := writer.Write(row); err != nil {
+				return err
+			}
+		}
+
+		subtotalRow := []string{"", CategoryLabel(group.category) + " subtotal"}
 		for _, project := range projectNames {
-			stats := projectData[project]
-			older := stats.older[status]
-			thisWeek := stats.thisWeek[status]
-			all := stats.all[status]
-
-			row = append(row,
-				fmt.Sprintf("%d", older),
-				fmt.Sprintf("%d", thisWeek),
-				fmt.Sprintf("%d", all),
+			subtotal := subtotals[project]
+			subtotalRow = append(subtotalRow,
+				fmt.Sprintf("%d", subtotal.older),
+				fmt.Sprintf("%d", subtotal.thisWeek),
+				fmt.Sprintf("%d", subtotal.all),
 			)
 
 			totals := projectTotals[project]
-			totals.older += older
-			totals.thisWeek += thisWeek
-			totals.all += all
+			totals.older += subtotal.older
+			totals.thisWeek += subtotal.thisWeek
+			totals.all += subtotal.all
 			projectTotals[project] = totals
 		}
-
-		if err := writer.Write(row); err != nil {
+		if err := writer.Write(subtotalRow); err != nil {
 			return err
 		}
 	}
@@ -252,6 +268,36 @@ func formatDatePtr(t *time.Time) string {
 	return t.Format("02/01/06")
 }
 
+type numberedTask struct {
+	task   Task
+	number string
+	depth  int
+}
+
+// numberTasks flattens tasks and their subtasks into rows numbered 1, 2, 2.1, 2.2, ...
+func numberTasks(tasks []Task) []numberedTask {
+	var rows []numberedTask
+	var walk func(tasks []Task, prefix string, depth int)
+	walk = func(tasks []Task, prefix string, depth int) {
+		for i, task := range tasks {
+			number := fmt.Sprintf("%s%d", prefix, i+1)
+			rows = append(rows, numberedTask{task: task, number: number, depth: depth})
+			walk(task.Children, number+".", depth+1)
+		}
+	}
+	walk(tasks, "", 0)
+	return rows
+}
+
+// formatDuration renders tracked time as hours and minutes, e.g. 3h 05m.
+func formatDuration(d time.Duration) string {
+	if d <= 0 {
+		return ""
+	}
+	minutes := int(d.Round(time.Minute).Minutes())
+	return fmt.Sprintf("%dh %02dm", minutes/60, minutes%60)
+}
+
 func normalizeStatus(status string) string {
 	status = strings.ToLower(strings.TrimSpace(status))
 	status = strings.ReplaceAll(status, "_", " ")
@@ -0,0 +1,56 @@
+package report
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// DateField selects which date places a task in the report period.
+type DateField string
+
+const (
+	DateCreated DateField = "created"
+	DateUpdated DateField = "updated"
+	DateClosed  DateField = "closed"
+	// DateAny matches tasks created, updated or closed in the period.
+	DateAny DateField = "any"
+)
+
+// ParseDateField parses a --date-field value. Empty means created.
+func ParseDateField(value string) (DateField, error) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "", "created":
+		return DateCreated, nil
+	case "updated":
+		return DateUpdated, nil
+	case "closed", "completed":
+		return DateClosed, nil
+	case "any", "any-activity":
+		return DateAny, nil
+	}
+	return "", fmt.Errorf("unknown date field %q (valid: created, updated, closed, any)", value)
+}
+
+// InPeriod reports whether the field's date falls within start and end,
+// inclusive. Zero bounds are open. The zero DateField means created.
+func InPeriod(field DateField, created, updated time.Time, closed *time.Time, start, end time.Time) bool {
+	within := func(t time.Time) bool {
+		return (start.IsZero() || !t.Before(start)) && (end.IsZero() || !t.After(end))
+	}
+
+	switch field {
+	case DateUpdated:
+		return within(updated)
+	case DateClosed:
+		return closed != nil && within(*closed)
+	case DateAny:
+		return within(created) || within(updated) || (closed != nil && within(*closed))
+	}
+	return within(created)
+}
+
+// InPeriod reports whether the task's field date falls within start and end.
+func (t Task) InPeriod(field DateField, start, end time.Time) bool {
+	return InPeriod(field, t.CreatedAt, t.UpdatedAt, t.CompletedAt, start, end)
+}
@@ -12,6 +12,9 @@ import (
 
 type ExcelExporter struct {
 	OutputDir string
+	// DateField decides which tasks the dashboard counts as reported in the
+	// period rather than older.
+	DateField DateField
 }
 
 func NewExcelExporter(outputDir string) *ExcelExporter {
@@ -74,32 +77,17 @@ func (e *ExcelExporter) createDashboardSheet(f *excelize.File, sheetName string,
 	}
 	f.SetActiveSheet(index)
 
-	statusOrder := []string{
-		"in progress",
-		"issues",
-		"improvements",
-		"ready for qa",
-		"ready for deployment",
-		"failed qa",
-		"backlog",
-		"current sprint",
-		"ready",
-		"todo",
-		"new development",
-		"on hold",
-		"urgent support",
-		"suspended",
-	}
-
 	type ProjectStats struct {
-		older    map[string]int
-		thisWeek map[string]int
-		all      map[string]int
+		older    map[statusKey]int
+		thisWeek map[statusKey]int
+		all      map[statusKey]int
 	}
 
 	projectData := make(map[string]*ProjectStats)
 
-	for _, task := range tasks {
+	// subtasks have statuses of their own and are counted like any other task
+	statusTasks := flattenSubtasks(tasks)
+	for _, task := range statusTasks {
 		project := task.Source
 		if project == "" || project == "ClickUp" {
 			project = "Unknown"
@@ -107,15 +95,15 @@ func (e *ExcelExporter) createDashboardSheet(f *excelize.File, sheetName string,
 
 		if projectData[project] == nil {
 			projectData[project] = &ProjectStats{
-				older:    make(map[string]int),
-				thisWeek: make(map[string]int),
-				all:      make(map[string]int),
+				older:    make(map[statusKey]int),
+				thisWeek: make(map[statusKey]int),
+				all:      make(map[statusKey]int),
 			}
 		}
 
-		status := strings.ToLower(strings.TrimSpace(task.Status))
+		status := statusOf(task)
 
-		isThisWeek := task.CreatedAt.After(start) || task.CreatedAt.Equal(start)
+		isThisWeek := task.InPeriod(e.DateField, start, time.Time{})
 
 		if isThisWeek {
 			projectData[project].thisWeek[status]++
@@ -160,6 +148,13 @@ func (e *ExcelExporter) createDashboardSheet(f *excelize.File, sheetName string,
 		},
 	})
 
+	subtotalStyle, _ := f.NewStyle(&excelize.Style{
+		Font: &excelize.Font{Bold: true},
+		Border: []excelize.Border{
+			{Type: "top", Color: "#000000", Style: 1},
+		},
+	})
+
 	f.SetCellValue(sheetName, "A1", "Date From:")
 	f.SetCellValue(sheetName, "B1", start.Format("02-01-06"))
 	f.SetCellValue(sheetName, "A2", "Date to:")
@@ -204,31 +199,64 @@ func (e *ExcelExporter) createDashboardSheet(f *excelize.File, sheetName string,
 
 	row++
 
-	projectTotals := make(map[string]struct{ older, thisWeek, all int })
-	for _, status := range statusOrder {
+	type counts struct{ older, thisWeek, all int }
+
+	// every status found is listed under its category, followed by the category subtotal
+	projectTotals := make(map[string]counts)
+	for _, group := range groupStatuses(statusTasks) {
+		subtotals := make(map[string]counts)
+		for _, status := range group.statuses {
+			col = 1
+			f.SetCellValue(sheetName, cellName(col, row), "")
+			col++
+			f.SetCellValue(sheetName, cellName(col, row), status.status)
+			col++
+
+			for _, project := range projectNames {
+				stats := projectData[project]
+				older := stats.older[status]
+				thisWeek := stats.thisWeek[status]
+				all := stats.all[status]
+
+				f.SetCellValue(sheetName, cellName(col, row), older)
+				col++
+				f.SetCellValue(sheetName, cellName(col, row), thisWeek)
+				col++
+				f.SetCellValue(sheetName, cellName(col, row), all)
+				col++
+
+				subtotal := subtotals[project]
+				subtotal.older += older
+				subtotal.thisWeek += thisWeek
+				subtotal.all += all
+				subtotals[project] = subtotal
+			}
+			row++
+		}
+
 		col = 1
 		f.SetCellValue(sheetName, cellName(col, row), "")
 		col++
-		f.SetCellValue(sheetName, cellName(col, row), status)
+		f.SetCellValue(sheetName, cellName(col, row), CategoryLabel(group.category)+" subtotal")
+		f.SetCellStyle(sheetName, cellName(col, row), cellName(col, row), subtotalStyle)
 		col++
 
 		for _, project := range projectNames {
-			stats := projectData[project]
-			older := stats.older[status]
-			thisWeek := stats.thisWeek[status]
-			all := stats.all[status]
-
-			f.SetCellValue(sheetName, cellName(col, row), older)
+			subtotal := subtotals[project]
+			f.SetCellValue(sheetName, cellName(col, row), subtotal.older)
+			f.SetCellStyle(sheetName, cellName(col, row), cellName(col, row), subtotalStyle)
 			col++
-			f.SetCellValue(sheetName, cellName(col, row), thisWeek)
+			f.SetCellValue(sheetName, cellName(col, row), subtotal.thisWeek)
+			f.SetCellStyle(sheetName, cellName(col, row), cellName(col, row), subtotalStyle)
 			col++
-			f.SetCellValue(sheetName, cellName(col, row), all)
+			f.SetCellValue(sheetName, cellName(col, row), subtotal.all)
+			f.SetCellStyle(sheetName, cellName(col, row), cellName(col, row), subtotalStyle)
 			col++
 
 			totals := projectTotals[project]
-			totals.older += older
-			totals.thisWeek += thisWeek
-			totals.all += all
+			totals.older += subtotal.older
+			totals.thisWeek += subtotal.thisWeek
+			totals.all += subtotal.all
 			projectTotals[project] = totals
 		}
 		row++
@@ -255,6 +283,12 @@ func (e *ExcelExporter) createDashboardSheet(f *excelize.File, sheetName string,
 		col++
 	}
 
+	row += 2
+	if e.writeMetricsTable(f, sheetName, row, tasks, projectNames, headerStyle) {
+		// the metrics table is five columns wide
+		col = max(col, 6)
+	}
+
 	f.SetColWidth(sheetName, "A", "A", 5)
 	f.SetColWidth(sheetName, "B", "B", 20)
 	for i := 2; i < col; i++ {
@@ -264,6 +298,51 @@ func (e *ExcelExporter) createDashboardSheet(f *excelize.File, sheetName string,
 	return nil
 }
 
+// writeMetricsTable writes per-project code-change totals starting at row.
+// It reports whether any project had metrics to write.
+func (e *ExcelExporter) writeMetricsTable(f *excelize.File, sheetName string, row int, tasks []Task, projectNames []string, headerStyle int) bool {
+	projectMetrics := make(map[string]*CodeMetrics)
+	for _, task := range flattenSubtasks(tasks) {
+		if task.Metrics == nil {
+			continue
+		}
+		project := task.Source
+		if project == "" || project == "ClickUp" {
+			project = "Unknown"
+		}
+		if projectMetrics[project] == nil {
+			projectMetrics[project] = &CodeMetrics{}
+		}
+		projectMetrics[project].Add(task.Metrics)
+	}
+	if len(projectMetrics) == 0 {
+		return false
+	}
+
+	headers := []string{"Code Changes", "Commits", "Lines Added", "Lines Removed", "Files Changed"}
+	for i, header := range headers {
+		cell := cellName(i+2, row)
+		f.SetCellValue(sheetName, cell, header)
+		f.SetCellStyle(sheetName, cell, cell, headerStyle)
+	}
+	row++
+
+	for _, project := range projectNames {
+		m := projectMetrics[project]
+		if m == nil {
+			continue
+		}
+		f.SetCellValue(sheetName, cellName(2, row), project)
+		f.SetCellValue(sheetName, cellName(3, row), m.Commits)
+		f.SetCellValue(sheetName, cellName(4, row), m.Additions)
+		f.SetCellValue(sheetName, cellName(5, row), m.Deletions)
+		f.SetCellValue(sheetName, cellName(6, row), m.FilesChanged)
+		row++
+	}
+
+	return true
+}
+
 func (e *ExcelExporter) createProjectSheet(f *excelize.File, sheetName string, tasks []Task, start, end time.Time) error {
 	index, err := f.NewSheet(sheetName)
 	if err != nil {
@@ -292,6 +371,7 @@ func (e *ExcelExporter) createProjectSheet(f *excelize.File, sheetName string, t
 		"Due Date",
 		"Priority",
 		"Date Cleared",
+		"Time Spent",
 		"Project Name",
 		"Challenges",
 		"Support Required",
@@ -305,35 +385,49 @@ func (e *ExcelExporter) createProjectSheet(f *excelize.File, sheetName string, t
 		f.SetCellStyle(sheetName, cell, cell, headerStyle)
 	}
 
-	for i, task := range tasks {
+	// subtasks are indented under their parent
+	indentStyles := make(map[int]int)
+	for i, entry := range numberTasks(tasks) {
+		task := entry.task
 		row := i + 2
 		projectName := task.Source
 		if projectName == "" || projectName == "ClickUp" {
 			projectName = "Unknown"
 		}
 
-		f.SetCellValue(sheetName, cellName(1, row), i+1)
+		f.SetCellValue(sheetName, cellName(1, row), entry.number)
 		f.SetCellValue(sheetName, cellName(2, row), task.Title)
+		if entry.depth > 0 {
+			style, ok := indentStyles[entry.depth]
+			if !ok {
+				style, _ = f.NewStyle(&excelize.Style{
+					Alignment: &excelize.Alignment{Indent: entry.depth * 2},
+				})
+				indentStyles[entry.depth] = style
+			}
+			f.SetCellStyle(sheetName, cellName(2, row), cellName(2, row), style)
+		}
 		f.SetCellValue(sheetName, cellName(3, row), task.Assignee)
 		f.SetCellValue(sheetName, cellName(4, row), normalizeStatus(task.Status))
 		f.SetCellValue(sheetName, cellName(5, row), formatDate(task.CreatedAt))
-		f.SetCellValue(sheetName, cellName(6, row), "") // Due date
-		f.SetCellValue(sheetName, cellName(7, row), "") // Priority
+		f.SetCellValue(sheetName, cellName(6, row), formatDatePtr(task.DueDate))
+		f.SetCellValue(sheetName, cellName(7, row), task.Priority)
 		f.SetCellValue(sheetName, cellName(8, row), formatDatePtr(task.CompletedAt))
-		f.SetCellValue(sheetName, cellName(9, row), projectName)
-		f.SetCellValue(sheetName, cellName(10, row), task.Challenges)
-		f.SetCellValue(sheetName, cellName(11, row), task.SupportRequired)
-		f.SetCellValue(sheetName, cellName(12, row), task.SupportFrom)
-		f.SetCellValue(sheetName, cellName(13, row), task.FollowUp)
+		f.SetCellValue(sheetName, cellName(9, row), formatDuration(task.TimeSpent))
+		f.SetCellValue(sheetName, cellName(10, row), projectName)
+		f.SetCellValue(sheetName, cellName(11, row), task.Challenges)
+		f.SetCellValue(sheetName, cellName(12, row), task.SupportRequired)
+		f.SetCellValue(sheetName, cellName(13, row), task.SupportFrom)
+		f.SetCellValue(sheetName, cellName(14, row), task.FollowUp)
 	}
 
 	f.SetColWidth(sheetName, "A", "A", 5)
 	f.SetColWidth(sheetName, "B", "B", 40)
 	f.SetColWidth(sheetName, "C", "C", 20)
 	f.SetColWidth(sheetName, "D", "D", 20)
-	f.SetColWidth(sheetName, "E", "H", 15)
-	f.SetColWidth(sheetName, "I", "I", 20)
-	f.SetColWidth(sheetName, "J", "M", 20)
+	f.SetColWidth(sheetName, "E", "I", 15)
+	f.SetColWidth(sheetName, "J", "J", 20)
+	f.SetColWidth(sheetName, "K", "N", 20)
 
 	f.SetPanes(sheetName, &excelize.Panes{
 		Freeze:      true,
@@ -37,6 +37,7 @@ func (e *Exporter) ExportHTML(tasks []Task, stats map[string]any, filename, auth
 	funcMap := template.FuncMap{
 		"title": cases.Title(language.English).String,
 		"sub":   func(a, b int) int { return a - b },
+		"duration": formatDuration,
 	}
 	tmpl, err := template.New("report.tmpl").Funcs(funcMap).ParseFS(templateFS, "templates/report.tmpl")
 	if err != nil {
@@ -61,8 +62,14 @@ func (e *Exporter) ExportHTML(tasks []Task, stats map[string]any, filename, auth
 		}
 	}
 
+	// discussions are listed in their own section rather than under each project
+	var discussions []Task
 	tasksByProject := make(map[string][]Task)
 	for _, task := range tasks {
+		if task.Type == "Discussion" {
+			discussions = append(discussions, task)
+			continue
+		}
 		source := task.Source
 		if source == "" {
 			source = "Uncategorized"
@@ -73,6 +80,8 @@ func (e *Exporter) ExportHTML(tasks []Task, stats map[string]any, filename, auth
 	type ProjectGroup struct {
 		ProjectName string
 		Tasks       []Task
+		Metrics     *CodeMetrics
+		TimeSpent   time.Duration
 	}
 	
 	var groupedTasks []ProjectGroup
@@ -80,6 +89,8 @@ func (e *Exporter) ExportHTML(tasks []Task, stats map[string]any, filename, auth
 		groupedTasks = append(groupedTasks, ProjectGroup{
 			ProjectName: projectName,
 			Tasks:       projectTasks,
+			Metrics:     sumMetrics(projectTasks),
+			TimeSpent:   sumTimeSpent(projectTasks),
 		})
 	}
 	
@@ -91,6 +102,7 @@ func (e *Exporter) ExportHTML(tasks []Task, stats map[string]any, filename, auth
 		"Date":        time.Now().Format("2006-01-02 15:04:05"),
 		"Tasks":       tasks,
 		"GroupedTasks": groupedTasks,
+		"Discussions": discussions,
 		"Stats":       stats,
 		"Year":        year,
 		"Department":  "Information Systems",
@@ -105,3 +117,28 @@ func (e *Exporter) ExportHTML(tasks []Task, stats map[string]any, filename, auth
 	fmt.Printf("HTML report saved: %s\n", outputPath)
 	return nil
 }
+
+// sumMetrics totals the code metrics of tasks and their subtasks, or returns
+// nil when none carry any.
+func sumMetrics(tasks []Task) *CodeMetrics {
+	var total *CodeMetrics
+	for _, task := range flattenSubtasks(tasks) {
+		if task.Metrics == nil {
+			continue
+		}
+		if total == nil {
+			total = &CodeMetrics{}
+		}
+		total.Add(task.Metrics)
+	}
+	return total
+}
+
+// sumTimeSpent totals the tracked time of tasks and their subtasks.
+func sumTimeSpent(tasks []Task) time.Duration {
+	var total time.Duration
+	for _, task := range flattenSubtasks(tasks) {
+		total += task.TimeSpent
+	}
+	return total
+}
@@ -9,6 +9,8 @@ import (
 
 type Generator struct {
 	Sources []ActivitySource
+	// Statuses overrides the status category of matching status names.
+	Statuses StatusMap
 }
 
 func NewGenerator(sources ...ActivitySource) *Generator {
@@ -43,9 +45,11 @@ func (g *Generator) Generate(ctx context.Context, user string, start, end time.T
 		}
 
 		fmt.Printf("Fetched %d tasks from %s\n", len(tasks), src.Name())
-		all = append(all, tasks...)
+		all = append(all, nestSubtasks(tasks)...)
 	}
 
+	g.Statuses.categorize(all)
+
 	sort.Slice(all, func(i, j int) bool {
 		return all[i].CreatedAt.After(all[j].CreatedAt)
 	})
@@ -63,22 +67,141 @@ func (g *Generator) Statistics(tasks []Task) map[string]any {
 
 	bySource := make(map[string]int)
 	byStatus := make(map[string]int)
+	byCategory := make(map[string]int)
 	byType := make(map[string]int)
+	metricsBySource := make(map[string]*CodeMetrics)
+	var metrics CodeMetrics
+	timeByProject := make(map[string]time.Duration)
+	timeByPerson := make(map[string]time.Duration)
+	var timeSpent time.Duration
 
 	completed := 0
+	reviews := 0
+	comments := 0
+	merged := 0
+	abandoned := 0
+	subtasks := 0
+	subtasksCompleted := 0
 	for _, task := range tasks {
 		bySource[task.Source]++
 		byStatus[task.Status]++
+		byCategory[statusOf(task).category]++
 		byType[task.Type]++
 		if task.CompletedAt != nil {
 			completed++
 		}
+		reviews += task.ReviewCount
+		comments += task.CommentCount
+
+		// subtasks count towards their parent, but their tracked time and
+		// code changes roll up into the totals
+		for i, t := range task.withSubtasks() {
+			if i > 0 {
+				subtasks++
+				if t.CompletedAt != nil {
+					subtasksCompleted++
+				}
+			}
+
+			if t.TimeSpent > 0 {
+				timeSpent += t.TimeSpent
+				timeByProject[task.Source] += t.TimeSpent
+				if len(t.TimeSpentBy) == 0 {
+					timeByPerson[t.Assignee] += t.TimeSpent
+				}
+				for person, d := range t.TimeSpentBy {
+					timeByPerson[person] += d
+				}
+			}
+
+			if t.Metrics != nil {
+				metrics.Add(t.Metrics)
+				if metricsBySource[task.Source] == nil {
+					metricsBySource[task.Source] = &CodeMetrics{}
+				}
+				metricsBySource[task.Source].Add(t.Metrics)
+			}
+		}
+
+		if isChangeRequest(task) {
+			switch task.Status {
+			case StatusMerged:
+				merged++
+			case StatusClosed:
+				abandoned++
+			}
+		}
 	}
 
 	stats["total"] = len(tasks)
 	stats["completed"] = completed
+	stats["subtasks"] = subtasks
+	stats["subtasks_completed"] = subtasksCompleted
+	stats["reviews"] = reviews
+	stats["comments"] = comments
+	stats["merged"] = merged
+	stats["abandoned"] = abandoned
 	stats["by_source"] = bySource
 	stats["by_status"] = byStatus
+	stats["by_category"] = byCategory
 	stats["by_type"] = byType
+	stats["metrics"] = metrics
+	stats["metrics_by_source"] = metricsBySource
+	stats["time_spent"] = timeSpent
+	stats["time_by_project"] = timeByProject
+	stats["time_by_person"] = timeByPerson
 	return stats
 }
+
+// nestSubtasks moves tasks whose parent is among tasks under that parent.
+// Subtasks whose parent was not fetched stay top-level, as do tasks caught in
+// a parent cycle, which keep the first of them as their root. Top-level tasks
+// lose their ParentID so they are not rendered as subtasks.
+func nestSubtasks(tasks []Task) []Task {
+	ids := make(map[string]bool, len(tasks))
+	for _, task := range tasks {
+		ids[task.ID] = true
+	}
+
+	children := make(map[string][]int)
+	var roots []int
+	for i, task := range tasks {
+		if task.ParentID != "" && task.ParentID != task.ID && ids[task.ParentID] {
+			children[task.ParentID] = append(children[task.ParentID], i)
+		} else {
+			roots = append(roots, i)
+		}
+	}
+	visited := make([]bool, len(tasks))
+	var attach func(i int) Task
+	attach = func(i int) Task {
+		visited[i] = true
+		task := tasks[i]
+		for _, child := range children[task.ID] {
+			if !visited[child] {
+				task.Children = append(task.Children, attach(child))
+			}
+		}
+		return task
+	}
+
+	var nested []Task
+	top := func(i int) {
+		task := attach(i)
+		task.ParentID = ""
+		nested = append(nested, task)
+	}
+	for _, i := range roots {
+		top(i)
+	}
+	for i := range tasks {
+		if !visited[i] {
+			top(i)
+		}
+	}
+	return nested
+}
+
+func isChangeRequest(task Task) bool {
+	return task.Type == "Pull Request" || task.Type == "Merge Request"
+}
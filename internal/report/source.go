@@ -2,15 +2,31 @@ package report
 
 import "time"
 
+// Statuses of pull/merge requests.
+const (
+	StatusDraft  = "draft"
+	StatusOpen   = "open"
+	StatusMerged = "merged"
+	StatusClosed = "closed"
+)
+
 type Task struct {
-	ID              string
-	Title           string
-	Description     string
-	Status          string
-	URL             string
-	CreatedAt       time.Time
-	UpdatedAt       time.Time
-	CompletedAt     *time.Time
+	ID          string
+	Title       string
+	Description string
+	Status      string
+	// StatusCategory is the normalized Status, one of the Category constants.
+	StatusCategory string
+	URL            string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	CompletedAt    *time.Time
+	DueDate        *time.Time
+	Priority       string
+	Estimate       time.Duration
+	TimeSpent      time.Duration
+	// TimeSpentBy breaks TimeSpent down by person.
+	TimeSpentBy     map[string]time.Duration
 	Source          string
 	Type            string
 	Labels          []string
@@ -21,6 +37,73 @@ type Task struct {
 	SupportFrom     string
 	FollowUp        string
 	AttachmentURL   string
+	ReviewCount     int
+	ReviewVerdict   string
+	CommentCount    int
+	Metrics         *CodeMetrics
+	// ParentID is the ID of the task this is a subtask of.
+	ParentID string
+	// Children are the subtasks fetched alongside the task.
+	Children []Task
+}
+
+// Overdue reports whether the task missed its due date: it was completed after
+// the due date, or is still open past it.
+func (t Task) Overdue() bool {
+	if t.DueDate == nil {
+		return false
+	}
+	if t.CompletedAt != nil {
+		return t.CompletedAt.After(*t.DueDate)
+	}
+	return time.Now().After(*t.DueDate)
+}
+
+// CompletedSubtasks counts the direct subtasks that are complete.
+func (t Task) CompletedSubtasks() int {
+	done := 0
+	for _, child := range t.Children {
+		if child.CompletedAt != nil {
+			done++
+		}
+	}
+	return done
+}
+
+// withSubtasks returns the task followed by all of its subtasks, depth first.
+func (t Task) withSubtasks() []Task {
+	all := []Task{t}
+	for _, child := range t.Children {
+		all = append(all, child.withSubtasks()...)
+	}
+	return all
+}
+
+// flattenSubtasks lists every task followed by its subtasks, depth first.
+func flattenSubtasks(tasks []Task) []Task {
+	var all []Task
+	for _, task := range tasks {
+		all = append(all, task.withSubtasks()...)
+	}
+	return all
+}
+
+type CodeMetrics struct {
+	Additions    int
+	Deletions    int
+	FilesChanged int
+	Commits      int
+}
+
+// Add accumulates other into m; a nil other is ignored.
+func (m *CodeMetrics) Add(other *CodeMetrics) {
+	if other == nil {
+		return
+	}
+	m.Additions += other.Additions
+	m.Deletions += other.Deletions
+	m.FilesChanged += other.FilesChanged
+	m.Commits += other.Commits
 }
 
 type ActivitySource interface {
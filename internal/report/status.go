@@ -0,0 +1,173 @@
+package report
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+)
+
+// Status categories normalize the statuses of every source.
+const (
+	CategoryTodo       = "todo"
+	CategoryInProgress = "in progress"
+	CategoryReview     = "review"
+	CategoryDone       = "done"
+	CategoryCancelled  = "cancelled"
+	CategoryBlocked    = "blocked"
+)
+
+// Categories lists the status categories in dashboard order.
+var Categories = []string{
+	CategoryTodo,
+	CategoryInProgress,
+	CategoryReview,
+	CategoryBlocked,
+	CategoryDone,
+	CategoryCancelled,
+}
+
+var categoryLabels = map[string]string{
+	CategoryTodo:       "To Do",
+	CategoryInProgress: "In Progress",
+	CategoryReview:     "Review",
+	CategoryDone:       "Done",
+	CategoryCancelled:  "Cancelled",
+	CategoryBlocked:    "Blocked",
+}
+
+// CategoryLabel returns the display name of a status category.
+func CategoryLabel(category string) string {
+	if label, ok := categoryLabels[category]; ok {
+		return label
+	}
+	return category
+}
+
+// statusWords guesses a category from words in a status name, checked in order.
+var statusWords = []struct {
+	category string
+	words    []string
+}{
+	{CategoryCancelled, []string{"cancelled", "canceled", "won't do", "wont do", "not planned", "rejected", "abandoned", "duplicate", "declined"}},
+	{CategoryBlocked, []string{"blocked", "on hold", "hold", "suspended", "waiting", "paused"}},
+	{CategoryDone, []string{"done", "complete", "completed", "closed", "merged", "resolved", "released", "deployed", "shipped", "fixed", "finished"}},
+	{CategoryReview, []string{"review", "in review", "code review", "qa", "testing", "approval", "deployment", "staging"}},
+	{CategoryInProgress, []string{"in progress", "progress", "doing", "started", "development", "wip", "draft", "sprint"}},
+	{CategoryTodo, []string{"todo", "to do", "open", "new", "backlog", "triage", "ready", "planned", "unstarted", "not started"}},
+}
+
+// GuessCategory derives a category from a status name. Unrecognised statuses
+// count as done once the task completed and as in progress otherwise.
+func GuessCategory(status string, completed bool) string {
+	name := " " + strings.NewReplacer("_", " ", "-", " ").Replace(strings.ToLower(strings.TrimSpace(status))) + " "
+	for _, group := range statusWords {
+		for _, word := range group.words {
+			if strings.Contains(name, " "+word+" ") {
+				return group.category
+			}
+		}
+	}
+	if completed {
+		return CategoryDone
+	}
+	return CategoryInProgress
+}
+
+// StatusMap assigns status names (matched case-insensitively) to categories,
+// e.g. {"Ready for QA": "review", "Parked": "blocked"}.
+type StatusMap map[string]string
+
+// LoadStatusMap reads a StatusMap from a JSON file.
+func LoadStatusMap(path string) (StatusMap, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read status map: %w", err)
+	}
+
+	var raw StatusMap
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("failed to parse status map %s: %w", path, err)
+	}
+
+	statuses := make(StatusMap, len(raw))
+	for status, category := range raw {
+		category = strings.ToLower(strings.TrimSpace(category))
+		if _, ok := categoryLabels[category]; !ok {
+			return nil, fmt.Errorf("status map %s: unknown category %q for %q", path, category, status)
+		}
+		statuses[strings.ToLower(strings.TrimSpace(status))] = category
+	}
+
+	return statuses, nil
+}
+
+// categorize sets the status category of the tasks and their subtasks. The
+// map wins over the category a source set; tasks with neither are guessed
+// from their status name.
+func (m StatusMap) categorize(tasks []Task) {
+	for i := range tasks {
+		task := &tasks[i]
+		if category, ok := m[strings.ToLower(strings.TrimSpace(task.Status))]; ok {
+			task.StatusCategory = category
+		} else if task.StatusCategory == "" {
+			task.StatusCategory = GuessCategory(task.Status, task.CompletedAt != nil)
+		}
+		m.categorize(task.Children)
+	}
+}
+
+// statusKey identifies a dashboard row: the same status name can fall into
+// different categories, such as closed issues (done) and closed PRs (cancelled).
+type statusKey struct {
+	category string
+	status   string
+}
+
+type statusGroup struct {
+	category string
+	statuses []statusKey
+}
+
+// statusOf returns the dashboard row of a task.
+func statusOf(task Task) statusKey {
+	status := strings.ToLower(strings.TrimSpace(task.Status))
+	if status == "" {
+		status = "(no status)"
+	}
+	category := task.StatusCategory
+	if category == "" {
+		category = GuessCategory(task.Status, task.CompletedAt != nil)
+	}
+	return statusKey{category: category, status: status}
+}
+
+// groupStatuses lists the statuses found in tasks under their categories, in
+// category order, with statuses sorted by name within each category.
+func groupStatuses(tasks []Task) []statusGroup {
+	found := make(map[string]map[string]bool)
+	for _, task := range tasks {
+		key := statusOf(task)
+		if found[key.category] == nil {
+			found[key.category] = make(map[string]bool)
+		}
+		found[key.category][key.status] = true
+	}
+
+	var groups []statusGroup
+	for _, category := range Categories {
+		if len(found[category]) == 0 {
+			continue
+		}
+		group := statusGroup{category: category}
+		for status := range found[category] {
+			group.statuses = append(group.statuses, statusKey{category: category, status: status})
+		}
+		sort.Slice(group.statuses, func(i, j int) bool {
+			return group.statuses[i].status < group.statuses[j].status
+		})
+		groups = append(groups, group)
+	}
+	return groups
+}